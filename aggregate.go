@@ -0,0 +1,282 @@
+package traveller
+
+import (
+	"math"
+	"reflect"
+	"sync"
+)
+
+// The numeric types that aggregations can produce, including named types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Get the sum of the numeric values matching the path as type T.
+//
+// Values of any integer or float kind are included, including named types.
+// Values that are not numeric are skipped. Integers are summed exactly while the sum fits in
+// int64 or uint64, and the sum is only converted to float64 once a float is included.
+// The sum is converted into T at the end, as with a Go conversion.
+func Sum[T Number](i any, mp []Matcher, options ...TravellerOption) T {
+	var sum number
+	eachNumber(i, mp, options, func(n number) {
+		sum = sum.add(n)
+	})
+	return numberAs[T](sum)
+}
+
+// Get the average of the numeric values matching the path.
+//
+// The second value will be false if there is no numeric value matching the path.
+func Avg(i any, mp []Matcher, options ...TravellerOption) (float64, bool) {
+	var (
+		sum   number
+		count int
+	)
+	eachNumber(i, mp, options, func(n number) {
+		sum = sum.add(n)
+		count++
+	})
+	if count == 0 {
+		return 0, false
+	}
+	return sum.float() / float64(count), true
+}
+
+// Get the smallest numeric value matching the path as type T.
+//
+// The values are compared exactly before the smallest one is converted into T.
+// The second value will be false if there is no numeric value matching the path.
+func Min[T Number](i any, mp []Matcher, options ...TravellerOption) (T, bool) {
+	return extreme[T](i, mp, options, func(a, b number) bool { return a.less(b) })
+}
+
+// Get the largest numeric value matching the path as type T.
+//
+// The values are compared exactly before the largest one is converted into T.
+// The second value will be false if there is no numeric value matching the path.
+func Max[T Number](i any, mp []Matcher, options ...TravellerOption) (T, bool) {
+	return extreme[T](i, mp, options, func(a, b number) bool { return b.less(a) })
+}
+
+// Get the distinct values of type T matching the path, in the order they are found.
+func Distinct[T any](i any, mp []Matcher, options ...TravellerOption) []T {
+	var (
+		vals    = make([]T, 0)
+		seen    = make(map[any]struct{})
+		uncomps []any
+	)
+
+outer:
+	for _, val := range GetAll[T](i, mp, options...) {
+		v := any(val)
+		if !isComparable(reflect.ValueOf(v)) {
+			// Values that cannot be map keys are compared one by one.
+			for _, u := range uncomps {
+				if reflect.DeepEqual(u, v) {
+					continue outer
+				}
+			}
+			uncomps = append(uncomps, v)
+		} else {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+		}
+		vals = append(vals, val)
+	}
+	return vals
+}
+
+// Group the values of type T matching the path by the key given by the function.
+// The values of each group are in the order they are found.
+func GroupBy[K comparable, T any](i any, mp []Matcher, key func(T) K, options ...TravellerOption) map[K][]T {
+	groups := make(map[K][]T)
+	for _, val := range GetAll[T](i, mp, options...) {
+		k := key(val)
+		groups[k] = append(groups[k], val)
+	}
+	return groups
+}
+
+// Whether the value can be used as a map key without panicking.
+// Unlike reflect.Type.Comparable, the values held by interfaces are checked as well.
+func isComparable(rv reflect.Value) bool {
+	if !rv.IsValid() {
+		return true
+	}
+	switch rv.Kind() {
+	case reflect.Interface:
+		return isComparable(rv.Elem())
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			if !isComparable(rv.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Array:
+		if !rv.Type().Comparable() {
+			return false
+		}
+		for i := 0; i < rv.Len(); i++ {
+			if !isComparable(rv.Index(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return rv.Type().Comparable()
+}
+
+// Call fn on each numeric value matching the path.
+// The calls never overlap, even with WithParallelism.
+func eachNumber(i any, mp []Matcher, options []TravellerOption, fn func(n number)) {
+	var mu sync.Mutex
+	onFound := func(f Found) (struct{}, bool, bool) {
+		if n, ok := numberOf(Unbox(f.RV())); ok {
+			mu.Lock()
+			fn(n)
+			mu.Unlock()
+		}
+		return struct{}{}, false, true // Keep searching.
+	}
+
+	findAll(reflect.ValueOf(i), mp, onFound, options)
+}
+
+// Get the number that is preferred over all other numbers as type T.
+func extreme[T Number](i any, mp []Matcher, options []TravellerOption, better func(a, b number) bool) (T, bool) {
+	var (
+		result number
+		found  bool
+	)
+	eachNumber(i, mp, options, func(n number) {
+		if !found || better(n, result) {
+			result, found = n, true
+		}
+	})
+	return numberAs[T](result), found
+}
+
+// The kind of value held by a number.
+type numberKind int
+
+const (
+	numberInt numberKind = iota
+	numberUint
+	numberFloat
+)
+
+// A numeric value, kept as an integer for as long as it is exact.
+// The zero value is the integer 0.
+type number struct {
+	kind numberKind
+	i    int64
+	u    uint64
+	f    float64
+}
+
+// Get the value of any integer or float kind as a number.
+func numberOf(rv reflect.Value) (number, bool) {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{kind: numberInt, i: rv.Int()}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return number{kind: numberUint, u: rv.Uint()}, true
+	case reflect.Float32, reflect.Float64:
+		return number{kind: numberFloat, f: rv.Float()}, true
+	}
+	return number{}, false
+}
+
+// Convert the number into T, as with a Go conversion.
+func numberAs[T Number](n number) T {
+	switch n.kind {
+	case numberInt:
+		return T(n.i)
+	case numberUint:
+		return T(n.u)
+	}
+	return T(n.f)
+}
+
+func (n number) float() float64 {
+	switch n.kind {
+	case numberInt:
+		return float64(n.i)
+	case numberUint:
+		return float64(n.u)
+	}
+	return n.f
+}
+
+// Get the integer as int64, if it fits.
+func (n number) int() (int64, bool) {
+	switch n.kind {
+	case numberInt:
+		return n.i, true
+	case numberUint:
+		return int64(n.u), n.u <= math.MaxInt64
+	}
+	return 0, false
+}
+
+// Get the integer as uint64, if it fits.
+func (n number) uint() (uint64, bool) {
+	switch n.kind {
+	case numberInt:
+		return uint64(n.i), n.i >= 0
+	case numberUint:
+		return n.u, true
+	}
+	return 0, false
+}
+
+// Add the numbers, falling back to float64 if either is a float or the sum overflows.
+func (n number) add(o number) number {
+	if a, ok := n.int(); ok {
+		if b, ok := o.int(); ok {
+			if sum := a + b; (b >= 0) == (sum >= a) {
+				return number{kind: numberInt, i: sum}
+			}
+		}
+	}
+	if a, ok := n.uint(); ok {
+		if b, ok := o.uint(); ok {
+			if sum := a + b; sum >= a {
+				return number{kind: numberUint, u: sum}
+			}
+		}
+	}
+	// A negative integer added to an integer above the range of int64 always fits in uint64.
+	switch {
+	case n.kind == numberUint && o.kind == numberInt && o.i < 0:
+		return number{kind: numberUint, u: n.u + uint64(o.i)}
+	case n.kind == numberInt && n.i < 0 && o.kind == numberUint:
+		return number{kind: numberUint, u: o.u + uint64(n.i)}
+	}
+	return number{kind: numberFloat, f: n.float() + o.float()}
+}
+
+// Whether the number is smaller than the other number.
+func (n number) less(o number) bool {
+	if n.kind == numberFloat || o.kind == numberFloat {
+		return n.float() < o.float()
+	}
+	a, aOk := n.int()
+	b, bOk := o.int()
+	switch {
+	case aOk && bOk:
+		return a < b
+	case aOk:
+		// The other integer is above the range of int64.
+		return true
+	case bOk:
+		return false
+	}
+	return n.u < o.u
+}
@@ -0,0 +1,90 @@
+package traveller_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ezraisw/traveller"
+)
+
+func (s GeneralTestSuite) TestCallSum() {
+	in := makeBulb()
+
+	s.Equal(2808, traveller.Sum[int](in, traveller.P("Federation.Clean.*")))
+	s.InDelta(1241.448, traveller.Sum[float64](in, traveller.P("Federation.Hate.Create.Fence.Issue.*")), 1e-9)
+	s.InDelta(2665.84, traveller.Sum[float64](in, traveller.P("Federation.Hate.*")), 1e-9)
+	s.Equal(2665, traveller.Sum[int](in, traveller.P("Federation.Hate.*")))
+	s.Equal(0, traveller.Sum[int](in, traveller.P("Worth.*")))
+	s.Equal(2808, traveller.Sum[int](&in, traveller.P("Federation.Clean.*"), traveller.WithParallelism(2)))
+
+	// Integers are summed exactly, even past the precision of float64.
+	big := []int64{1 << 53, 1, 1}
+	s.Equal(int64(1<<53+2), traveller.Sum[int64](big, traveller.P("*")))
+	s.Equal(float64(1<<53+2), traveller.Sum[float64](big, traveller.P("*")))
+	s.Equal(1, traveller.Sum[int]([]any{1, uint64(1 << 63), int64(math.MinInt64)}, traveller.P("*")))
+	s.Equal(uint64(math.MaxUint64), traveller.Sum[uint64]([]any{uint64(1 << 63), uint64(1<<63 - 1)}, traveller.P("*")))
+	avg, ok := traveller.Avg([]any{uint64(1 << 63), uint64(1 << 63), 0.5}, traveller.P("*"))
+	s.True(ok)
+	s.Equal((1<<64+0.5)/3, avg)
+
+	// Named types are included and produced.
+	type price int64
+	s.Equal(price(160), traveller.Sum[price]([]price{30, 120, 10}, traveller.P("*")))
+}
+
+func (s GeneralTestSuite) TestCallAvgMinMax() {
+	in := makeBulb()
+
+	avg, ok := traveller.Avg(in, traveller.P("Federation.Clean.*"))
+	s.True(ok)
+	s.Equal(468.0, avg)
+
+	lowest, ok := traveller.Min[int](in, traveller.P("Federation.Clean.*"))
+	s.True(ok)
+	s.Equal(168, lowest)
+
+	highest, ok := traveller.Max[float64](in, traveller.P("Federation.Hate.*"))
+	s.True(ok)
+	s.Equal(925.840, highest)
+
+	// Integers are compared exactly.
+	highestUint, ok := traveller.Max[uint64]([]any{uint64(1<<63 + 1), uint64(1 << 63), -1}, traveller.P("*"))
+	s.True(ok)
+	s.Equal(uint64(1<<63+1), highestUint)
+
+	_, ok = traveller.Avg(in, traveller.P("Worth.*"))
+	s.False(ok)
+	_, ok = traveller.Min[int](in, traveller.P("Missing"))
+	s.False(ok)
+	_, ok = traveller.Max[int](in, traveller.P("Missing"))
+	s.False(ok)
+}
+
+func (s GeneralTestSuite) TestCallDistinct() {
+	in := makeBulb()
+	in.Worth = append(in.Worth, in.Worth[0])
+	in.Federation.Hate.Critic = in.Federation.Hate.Couple
+
+	s.Equal(makeBulb().Worth, traveller.Distinct[string](in, traveller.P("Worth.*")))
+	s.Equal([][]int{{515, 133}}, traveller.Distinct[[]int](in, traveller.P("Federation.Hate.C*")))
+	s.Equal([]any{in.Federation.Hate.Couple, in.Federation.Hate.College, in.Federation.Hate.Create}, traveller.Distinct[any](in, traveller.P("Federation.Hate.C*")))
+
+	// Comparable types holding values that cannot be map keys are compared one by one.
+	jets := []facade{in.Federation.Jet, in.Federation.Jet}
+	s.Equal([]facade{in.Federation.Jet}, traveller.Distinct[facade](jets, traveller.P("*")))
+}
+
+func (s GeneralTestSuite) TestCallGroupBy() {
+	in := makeBulb()
+
+	groups := traveller.GroupBy(in, traveller.P("Federation.Hate.Create.Fence.Knowledge.Job.*"), func(val any) string {
+		return fmt.Sprintf("%T", val)
+	})
+	job := in.Federation.Hate.Create.Fence.Knowledge.Job.([]any)
+	s.Equal(map[string][]any{
+		"string":               {job[0]},
+		"int":                  {job[1], job[2]},
+		"traveller_test.swipe": {job[3]},
+		"[2]string":            {job[4]},
+	}, groups)
+}
@@ -0,0 +1,138 @@
+package traveller_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ezraisw/traveller"
+)
+
+func makeDeepInput(depth int) any {
+	var in any = map[string]any{"id": depth}
+	for i := depth - 1; i >= 0; i-- {
+		in = map[string]any{"id": i, "child": in}
+	}
+	return in
+}
+
+func makeWideInput(width int) any {
+	items := make([]any, 0, width)
+	for i := 0; i < width; i++ {
+		items = append(items, map[string]any{
+			"id":   i,
+			"name": "item" + strconv.Itoa(i),
+			"tags": []any{"a", "b"},
+		})
+	}
+	return map[string]any{"items": items}
+}
+
+// A record whose fields are loaded on access, such as a lazy-loaded proxy.
+type lazyRecord struct {
+	id int
+}
+
+func (r lazyRecord) TravellerKeys() []any {
+	return []any{"id"}
+}
+
+func (r lazyRecord) TravellerGet(key any) (any, bool) {
+	if key != "id" {
+		return nil, false
+	}
+	time.Sleep(50 * time.Microsecond)
+	return r.id, true
+}
+
+func (r lazyRecord) TravellerSet(key, val any) bool {
+	return false
+}
+
+func makeLazyInput(width int) any {
+	items := make([]any, 0, width)
+	for i := 0; i < width; i++ {
+		items = append(items, lazyRecord{id: i})
+	}
+	return map[string]any{"items": items}
+}
+
+func BenchmarkGetAllDeep(b *testing.B) {
+	in := makeDeepInput(1000)
+	mp := traveller.P("**.id")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		traveller.GetAll[int](in, mp)
+	}
+}
+
+func BenchmarkGetAllWide(b *testing.B) {
+	in := makeWideInput(1000)
+	mp := traveller.P("items.*.name")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		traveller.GetAll[string](in, mp)
+	}
+}
+
+func BenchmarkGetAllWideDescendant(b *testing.B) {
+	in := makeWideInput(1000)
+	mp := traveller.P("**")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		traveller.GetAll[string](in, mp)
+	}
+}
+
+func BenchmarkGetAllWideDescendantParallel(b *testing.B) {
+	in := makeWideInput(1000)
+	mp := traveller.P("**")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		traveller.GetAll[string](in, mp, traveller.WithParallelism(4))
+	}
+}
+
+func BenchmarkGetFirst(b *testing.B) {
+	in := makeWideInput(1000)
+	mp := traveller.P("items.*.id")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		traveller.Get[int](in, mp)
+	}
+}
+
+func BenchmarkSetAllWide(b *testing.B) {
+	in := makeWideInput(1000)
+	mp := traveller.P("items.*.id")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		traveller.SetAll(&in, mp, 0)
+	}
+}
+
+func BenchmarkGetAllLazy(b *testing.B) {
+	in := makeLazyInput(100)
+	mp := traveller.P("items.*.id")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		traveller.GetAll[int](in, mp)
+	}
+}
+
+func BenchmarkGetAllLazyParallel(b *testing.B) {
+	in := makeLazyInput(100)
+	mp := traveller.P("items.*.id")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		traveller.GetAll[int](in, mp, traveller.WithParallelism(4))
+	}
+}
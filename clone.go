@@ -0,0 +1,266 @@
+package traveller
+
+import (
+	"container/list"
+	"reflect"
+	"sync"
+)
+
+// Deep copy the given value.
+//
+// Structs, maps, slices, arrays, pointers, and interfaces are copied recursively.
+// Aliasing is preserved, values that are shared in the original (such as the same pointer
+// used twice) are shared in the copy as well, unless different locations are excluded inside them. *sync.Map and *list.List are rebuilt with
+// copies of their values. Unexported fields are copied recursively when WithUnexported is set,
+// otherwise they are copied as is, sharing the values they point to with the original.
+//
+// Values matching the paths given through WithExclude are left as their zero value
+// in the copy. Map entries matching the paths are left out of the copy.
+func Clone[T any](v T, options ...TravellerOption) T {
+	inRv := reflect.ValueOf(&v).Elem()
+
+	traveller := &Traveller{}
+	traveller.applyOptions(options)
+
+	c := cloner{
+		traveller: traveller,
+		seen:      make(map[cloneRef]reflect.Value),
+	}
+
+	outRv := reflect.New(inRv.Type())
+	c.cloneInto(outRv.Elem(), inRv, traveller.findExcluded(inRv))
+	return *outRv.Interface().(*T)
+}
+
+// The identity of a value that can be shared.
+type cloneRef struct {
+	typ reflect.Type
+	ptr uintptr
+	len int
+
+	// The locations excluded inside the value. A shared value is copied once for each set of
+	// excluded locations it is found with, so that it is only shared where its copies are equal.
+	excluded *locationTrie
+}
+
+// Obtain the identity of a shared value, which is a non-nil pointer, map, or slice.
+func cloneRefOf(rv reflect.Value) (cloneRef, bool) {
+	if rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Map:
+		if !rv.IsNil() {
+			return cloneRef{typ: rv.Type(), ptr: rv.Pointer()}, true
+		}
+	case reflect.Slice:
+		if !rv.IsNil() {
+			return cloneRef{typ: rv.Type(), ptr: rv.Pointer(), len: rv.Len()}, true
+		}
+	}
+	return cloneRef{}, false
+}
+
+// Deep copier that keeps track of the copied shared values.
+type cloner struct {
+	traveller *Traveller
+	seen      map[cloneRef]reflect.Value
+}
+
+// Deep copy the value, leaving out the excluded locations.
+func (c cloner) clone(rv reflect.Value, excluded *locationTrie) reflect.Value {
+	newRv := reflect.New(rv.Type()).Elem()
+	c.cloneInto(newRv, rv, excluded)
+	return newRv
+}
+
+// Deep copy the value into newRv, which must be a settable zero value of the same type.
+// Values are copied in place, so that containers such as sync.Map are not copied after use.
+func (c cloner) cloneInto(newRv, rv reflect.Value, excluded *locationTrie) {
+	if isContainerType(rv.Type()) && rv.CanInterface() {
+		c.cloneContainerValue(newRv, rv, excluded)
+		return
+	}
+
+	switch rv.Kind() {
+	case reflect.Interface:
+		if !rv.IsNil() {
+			newRv.Set(c.clone(rv.Elem(), excluded))
+		}
+	case reflect.Ptr:
+		newRv.Set(c.clonePtr(rv, excluded))
+	case reflect.Map:
+		newRv.Set(c.cloneMap(rv, excluded))
+	case reflect.Slice:
+		newRv.Set(c.cloneSlice(rv, excluded))
+	case reflect.Array:
+		c.cloneElems(newRv, rv, excluded)
+	case reflect.Struct:
+		c.cloneStruct(newRv, rv, excluded)
+	default:
+		newRv.Set(rv)
+	}
+}
+
+func (c cloner) clonePtr(rv reflect.Value, excluded *locationTrie) reflect.Value {
+	if rv.IsNil() {
+		return rv
+	}
+	ref := cloneRef{typ: rv.Type(), ptr: rv.Pointer(), excluded: excluded}
+	if newRv, ok := c.seen[ref]; ok {
+		return newRv
+	}
+
+	newRv := reflect.New(rv.Type().Elem())
+	c.seen[ref] = newRv
+	c.cloneInto(newRv.Elem(), rv.Elem(), excluded)
+	return newRv
+}
+
+func (c cloner) cloneMap(rv reflect.Value, excluded *locationTrie) reflect.Value {
+	if rv.IsNil() {
+		return rv
+	}
+	ref := cloneRef{typ: rv.Type(), ptr: rv.Pointer(), excluded: excluded}
+	if newRv, ok := c.seen[ref]; ok {
+		return newRv
+	}
+
+	newRv := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+	c.seen[ref] = newRv
+	for it := rv.MapRange(); it.Next(); {
+		childExcluded := excluded.child(it.Key().Interface())
+		if childExcluded.isEnd() {
+			continue
+		}
+		newRv.SetMapIndex(it.Key(), c.clone(it.Value(), childExcluded))
+	}
+	return newRv
+}
+
+func (c cloner) cloneSlice(rv reflect.Value, excluded *locationTrie) reflect.Value {
+	if rv.IsNil() {
+		return rv
+	}
+	ref := cloneRef{typ: rv.Type(), ptr: rv.Pointer(), len: rv.Len(), excluded: excluded}
+	if newRv, ok := c.seen[ref]; ok {
+		return newRv
+	}
+
+	newRv := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Cap())
+	c.seen[ref] = newRv
+	c.cloneElems(newRv, rv, excluded)
+	return newRv
+}
+
+// Deep copy the elements of an array or a slice into newRv.
+func (c cloner) cloneElems(newRv, rv reflect.Value, excluded *locationTrie) {
+	for i := 0; i < rv.Len(); i++ {
+		childExcluded := excluded.child(i)
+		if childExcluded.isEnd() {
+			continue
+		}
+		c.cloneInto(newRv.Index(i), rv.Index(i), childExcluded)
+	}
+}
+
+// Deep copy the struct into newRv.
+// Unexported fields are copied as is, unless they are traversed through WithUnexported.
+func (c cloner) cloneStruct(newRv, rv reflect.Value, excluded *locationTrie) {
+	newRv.Set(rv)
+
+	rt := rv.Type()
+	for i := 0; i < rv.NumField(); i++ {
+		field := rt.Field(i)
+		name, ok := c.traveller.FieldName(field)
+		if !field.IsExported() && !ok {
+			continue
+		}
+
+		fieldRv := newRv.Field(i)
+		if !field.IsExported() {
+			fieldRv = accessField(fieldRv)
+		}
+		fieldRv.Set(reflect.Zero(field.Type))
+
+		var childExcluded *locationTrie
+		if ok {
+			childExcluded = excluded.child(name)
+		}
+		if childExcluded.isEnd() {
+			continue
+		}
+		c.cloneInto(fieldRv, c.traveller.Field(rv, i), childExcluded)
+	}
+}
+
+// Deep copy the built-in container into newRv, rebuilding it with copies of its values.
+// The values of excluded keys are left out of a *sync.Map, and are nil in a *list.List.
+func (c cloner) cloneContainerValue(newRv, rv reflect.Value, excluded *locationTrie) {
+	if rv.Kind() != reflect.Ptr {
+		// Values that are not addressable are read from a copy, sharing the same content.
+		srcRv := rv
+		if !srcRv.CanAddr() {
+			srcRv = reflect.New(rv.Type()).Elem()
+			srcRv.Set(rv)
+		}
+		c.cloneContainer(newRv.Addr().Interface(), srcRv.Addr().Interface(), excluded)
+		return
+	}
+
+	if rv.IsNil() {
+		return
+	}
+	ref := cloneRef{typ: rv.Type(), ptr: rv.Pointer(), excluded: excluded}
+	if seenRv, ok := c.seen[ref]; ok {
+		newRv.Set(seenRv)
+		return
+	}
+
+	ptrRv := reflect.New(rv.Type().Elem())
+	c.seen[ref] = ptrRv
+	c.cloneContainer(ptrRv.Interface(), rv.Interface(), excluded)
+	newRv.Set(ptrRv)
+}
+
+// Copy the values of the container src into the empty container dst of the same type.
+func (c cloner) cloneContainer(dst, src any, excluded *locationTrie) {
+	switch src := src.(type) {
+	case *sync.Map:
+		dst := dst.(*sync.Map)
+		for _, key := range (syncMapTraversable{m: src}).TravellerKeys() {
+			childExcluded := excluded.child(key)
+			if childExcluded.isEnd() {
+				continue
+			}
+			if val, ok := src.Load(key); ok {
+				dst.Store(key, c.cloneAny(val, childExcluded))
+			}
+		}
+	case *list.List:
+		dst := dst.(*list.List).Init()
+		i := 0
+		for e := src.Front(); e != nil; e = e.Next() {
+			var val any
+			if childExcluded := excluded.child(i); !childExcluded.isEnd() {
+				val = c.cloneAny(e.Value, childExcluded)
+			}
+			dst.PushBack(val)
+			i++
+		}
+	}
+}
+
+// Deep copy a value held by an interface.
+func (c cloner) cloneAny(val any, excluded *locationTrie) any {
+	return c.clone(reflect.ValueOf(&val).Elem(), excluded).Interface()
+}
+
+// Deep copy the given value without any exclusions.
+func deepCopy(rv reflect.Value) reflect.Value {
+	c := cloner{
+		traveller: &Traveller{},
+		seen:      make(map[cloneRef]reflect.Value),
+	}
+	return c.clone(rv, nil)
+}
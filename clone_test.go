@@ -0,0 +1,90 @@
+package traveller_test
+
+import "github.com/ezraisw/traveller"
+
+func (s GeneralTestSuite) TestCallClone() {
+	in := makeBulb()
+	out := traveller.Clone(in)
+	s.Equal(in, out)
+
+	out.Cup["Houseplant"].(map[string]string)["Mislead"] = "edited"
+	out.Federation.Hate.Create.Fence.Knowledge.Job.([]any)[0] = "edited"
+	out.Worth[0] = "edited"
+	s.Equal(makeBulb(), in)
+}
+
+func (s GeneralTestSuite) TestCallCloneAliasing() {
+	shared := &chain{Name: "shared"}
+	in := &chain{Name: "root", Shared: shared, Children: []*chain{shared}}
+	in.Next = in
+
+	out := traveller.Clone(in)
+	s.NotSame(in, out)
+	s.Same(out, out.Next)
+	s.NotSame(shared, out.Shared)
+	s.Same(out.Shared, out.Children[0])
+	s.Equal("shared", out.Shared.Name)
+}
+
+func (s GeneralTestSuite) TestCallCloneExclude() {
+	in := &chain{
+		Name:  "root",
+		Cache: map[string]any{"key": "value"},
+		Children: []*chain{
+			{Name: "first", Cache: map[string]any{"key": "value"}},
+		},
+	}
+
+	out := traveller.Clone(in, traveller.WithExclude(traveller.P("**.Cache")))
+	s.Equal(&chain{
+		Name:     "root",
+		Cache:    map[string]any{"key": "value"},
+		Children: []*chain{{Name: "first"}},
+	}, out)
+	s.Equal(map[string]any{"key": "value"}, in.Children[0].Cache)
+
+	out = traveller.Clone(in, traveller.WithExclude(traveller.P("Cache.key"), traveller.P("Children.0")), traveller.WithParseKeys(true))
+	s.Equal(&chain{
+		Name:     "root",
+		Cache:    map[string]any{},
+		Children: []*chain{nil},
+	}, out)
+}
+
+func (s GeneralTestSuite) TestCallCloneExcludeShared() {
+	shared := &chain{Name: "shared", Cache: map[string]any{"secret": "value"}}
+	in := &chain{Name: "root", Shared: shared, Children: []*chain{shared}}
+
+	out := traveller.Clone(in, traveller.WithExclude(traveller.P("Shared.Cache")))
+	s.Nil(out.Shared.Cache)
+	s.Equal(map[string]any{"secret": "value"}, out.Children[0].Cache)
+	s.NotSame(out.Shared, out.Children[0])
+
+	// Cyclic values share the excluded locations of their first occurrence.
+	in = &chain{Name: "root", Cache: map[string]any{"secret": "value"}}
+	in.Next = in
+	in.Children = []*chain{{Name: "child", Next: in, Cache: map[string]any{"secret": "value"}}}
+
+	out = traveller.Clone(in, traveller.WithExclude(traveller.P("**.Cache")))
+	s.Nil(out.Cache)
+	s.Same(out, out.Next)
+	s.Same(out, out.Children[0].Next)
+	s.Nil(out.Children[0].Cache)
+	s.Equal(map[string]any{"secret": "value"}, in.Cache)
+}
+
+func (s GeneralTestSuite) TestCallCloneUnexported() {
+	// Unexported fields are shared unless they are traversed.
+	in := makeBulb()
+	traveller.Clone(in).Federation.Jet.lining.([]string)[0] = "shared"
+	s.Equal([]string{"shared", "Pe6JhS3uDf9GaY0oRi5x"}, in.Federation.Jet.lining)
+
+	in = makeBulb()
+	traveller.Clone(in, traveller.WithUnexported(true)).Federation.Jet.lining.([]string)[0] = "edited"
+	s.Equal(makeBulb(), in)
+}
+
+func (s GeneralTestSuite) TestCallGetAllExclude() {
+	actual := traveller.GetAll[string](makeBulb(), traveller.P("Federation.Jet.*"), traveller.WithExclude(traveller.P("**.Party")))
+	s.ElementsMatch([]string{"zJwMx0qTQaYWqKsOABNf"}, actual)
+}
@@ -0,0 +1,170 @@
+package traveller_test
+
+import (
+	"container/list"
+	"reflect"
+	"sync"
+
+	"github.com/ezraisw/traveller"
+)
+
+type containerState struct {
+	Cache  sync.Map
+	Queue  *list.List
+	Shared *sync.Map
+}
+
+func makeContainerState() *containerState {
+	state := &containerState{
+		Queue:  list.New(),
+		Shared: &sync.Map{},
+	}
+	state.Cache.Store("b", map[string]any{"hits": 2})
+	state.Cache.Store("a", map[string]any{"hits": 1})
+	state.Queue.PushBack("first")
+	state.Queue.PushBack("second")
+	state.Shared.Store("key", "value")
+	return state
+}
+
+func (s GeneralTestSuite) TestCallGetSyncMap() {
+	state := makeContainerState()
+
+	s.Equal(1, traveller.MustGet[int](state, traveller.P("Cache.a.hits")))
+	s.Equal("value", traveller.MustGet[string](state, traveller.P("Shared.key")))
+	s.Equal([]int{1, 2}, traveller.GetAll[int](state, traveller.P("Cache.*.hits")))
+	s.Equal([]int{1, 2}, traveller.GetAll[int](state, traveller.P("**.hits")))
+
+	_, ok := traveller.Get[any](state, traveller.P("Cache.c"))
+	s.False(ok)
+}
+
+func (s GeneralTestSuite) TestCallSetSyncMap() {
+	state := makeContainerState()
+
+	traveller.SetAll(state, traveller.P("Cache.*.hits"), 0)
+	s.Equal([]int{0, 0}, traveller.GetAll[int](state, traveller.P("Cache.*.hits")))
+
+	traveller.SetAll(state, traveller.P("Shared.key"), "new")
+	val, ok := state.Shared.Load("key")
+	s.True(ok)
+	s.Equal("new", val)
+}
+
+func (s GeneralTestSuite) TestCallGetList() {
+	state := makeContainerState()
+
+	s.Equal("second", traveller.MustGet[string](state, traveller.P("Queue.1")))
+	s.Equal([]string{"first", "second"}, traveller.GetAll[string](state, traveller.P("Queue.*")))
+
+	_, ok := traveller.Get[string](state, traveller.P("Queue.2"))
+	s.False(ok)
+}
+
+func (s GeneralTestSuite) TestCallSetList() {
+	state := makeContainerState()
+
+	traveller.SetAll(state, traveller.P("Queue.0"), "changed")
+	s.Equal("changed", state.Queue.Front().Value)
+	s.Equal(2, state.Queue.Len())
+}
+
+func (s GeneralTestSuite) TestCallDeleteContainers() {
+	state := makeContainerState()
+	state.Queue.PushBack("third")
+
+	// The indexes keep referring to the same elements while removing.
+	s.Equal(2, traveller.Delete(state, traveller.MustJSONPath("$.Queue[0,2,0]")))
+	s.Equal([]string{"second"}, traveller.GetAll[string](state, traveller.P("Queue.*")))
+
+	s.Equal(2, traveller.Delete(state, traveller.P("Cache.*")))
+	s.Empty(traveller.GetAll[any](state, traveller.P("Cache.*")))
+
+	s.Equal(1, traveller.Delete(state, traveller.P("Shared.key")))
+	s.Equal(0, traveller.Delete(state, traveller.P("Shared.key")))
+	s.Equal(1, traveller.Delete(state, traveller.P("Queue.*")))
+	s.Equal(0, state.Queue.Len())
+}
+
+func (s GeneralTestSuite) TestCallDiffContainers() {
+	a, b := makeContainerState(), makeContainerState()
+	s.Empty(traveller.Diff(a, b))
+
+	b.Cache.Store("a", map[string]any{"hits": 5})
+	b.Cache.Delete("b")
+	b.Shared.Store("other", 1)
+	b.Queue.Front().Value = "changed"
+	b.Queue.PushBack("third")
+
+	s.Equal([]traveller.Change{
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Cache", "a", "hits"}, From: 1, To: 5},
+		{Type: traveller.ChangeRemoved, Location: traveller.Location{"Cache", "b"}, From: map[string]any{"hits": 2}},
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Queue", 0}, From: "first", To: "changed"},
+		{Type: traveller.ChangeAdded, Location: traveller.Location{"Queue", 2}, To: "third"},
+		{Type: traveller.ChangeAdded, Location: traveller.Location{"Shared", "other"}, To: 1},
+	}, traveller.Diff(a, b))
+
+	// Values are compared the same way when the structs are not addressable.
+	s.Len(traveller.Diff(reflect.ValueOf(a).Elem().Interface(), reflect.ValueOf(b).Elem().Interface()), 5)
+}
+
+func (s GeneralTestSuite) TestCallCloneContainers() {
+	in := makeContainerState()
+	out := traveller.Clone(in)
+	s.Empty(traveller.Diff(in, out))
+	s.NotSame(in.Queue, out.Queue)
+	s.NotSame(in.Shared, out.Shared)
+
+	out.Cache.Store("c", 3)
+	val, _ := out.Cache.Load("a")
+	val.(map[string]any)["hits"] = 10
+	out.Queue.PushBack("third")
+	out.Queue.Front().Value = "changed"
+	out.Shared.Store("key", "changed")
+
+	s.Empty(traveller.Diff(makeContainerState(), in))
+	s.Len(traveller.Diff(in, out), 5)
+
+	out = traveller.Clone(in, traveller.WithExclude(traveller.P("Cache.b"), traveller.P("Queue.0")))
+	_, ok := out.Cache.Load("b")
+	s.False(ok)
+	s.Equal([]any{nil, "second"}, traveller.GetAll[any](out, traveller.P("Queue.*")))
+}
+
+func (s GeneralTestSuite) TestCallUpdateAllContainers() {
+	in := makeContainerState()
+
+	out, count := traveller.UpdateAll(in, traveller.P("Cache.a"), func(any) (any, bool, bool) {
+		return "changed", true, true
+	})
+	s.Equal(1, count)
+	s.Equal("changed", traveller.MustGet[string](out, traveller.P("Cache.a")))
+	s.Equal(2, traveller.MustGet[int](out, traveller.P("Cache.b.hits")))
+
+	out = traveller.With(out, traveller.P("Queue.1"), "changed")
+	out = traveller.With(out, traveller.P("Shared.key"), "changed")
+	s.Equal([]string{"first", "changed"}, traveller.GetAll[string](out, traveller.P("Queue.*")))
+	s.Equal("changed", traveller.MustGet[string](out, traveller.P("Shared.key")))
+	s.NotSame(in.Queue, out.Queue)
+	s.NotSame(in.Shared, out.Shared)
+
+	// The original containers are left untouched.
+	s.Empty(traveller.Diff(makeContainerState(), in))
+}
+
+func (s GeneralTestSuite) TestCallJSONPathContainers() {
+	state := makeContainerState()
+	state.Queue.PushBack(map[string]any{"hits": 3})
+
+	s.Equal([]any{"second"}, traveller.GetAll[any](state.Queue, traveller.MustJSONPath("$[1]")))
+	s.Equal([]any{"second"}, traveller.GetAll[any](state, traveller.MustJSONPath("$.Queue[-2]")))
+	s.Equal([]any{"first", "second"}, traveller.GetAll[any](state, traveller.MustJSONPath("$.Queue[:2]")))
+	s.Equal([]string{"second", "first"}, traveller.GetAll[string](state, traveller.MustJSONPath("$.Queue[::-1]")))
+	s.Equal([]string{"first", "second"}, traveller.GetAll[string](state, traveller.MustJSONPath("$.Queue[*]")))
+	s.Equal([]int{3}, traveller.GetAll[int](state, traveller.MustJSONPath("$.Queue[?@.hits > 2].hits")))
+	s.Equal([]int{1, 2, 3}, traveller.GetAll[int](state, traveller.MustJSONPath("$..hits")))
+
+	s.Equal(1, traveller.SetAll(state, traveller.MustJSONPath("$.Queue[0]"), "changed"))
+	s.Equal("changed", state.Queue.Front().Value)
+	s.Empty(traveller.GetAll[any](state, traveller.MustJSONPath("$.Cache[0]")))
+}
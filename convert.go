@@ -0,0 +1,236 @@
+package traveller
+
+import (
+	"math"
+	"reflect"
+)
+
+// Convert val into a value of the given type.
+//
+// Besides assignable values, generic values such as the ones decoded from JSON are converted.
+// Numbers are converted between numeric kinds if no information is lost, maps are converted
+// into structs and maps, and slices are converted into slices and arrays. Pointers are
+// allocated as needed. Struct fields are named by FieldName and unknown keys are ignored.
+func (t *Traveller) convertValue(val any, typ reflect.Type) (reflect.Value, bool) {
+	if val == nil {
+		return assignableValue(nil, typ)
+	}
+	return t.convert(reflect.ValueOf(val), typ)
+}
+
+func (t *Traveller) convert(rv reflect.Value, typ reflect.Type) (reflect.Value, bool) {
+	if rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return assignableValue(nil, typ)
+		}
+		rv = rv.Elem()
+	}
+	if rv.Type().AssignableTo(typ) {
+		return rv, true
+	}
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		elemRv, ok := t.convert(rv, typ.Elem())
+		if !ok {
+			return reflect.Value{}, false
+		}
+		newRv := reflect.New(typ.Elem())
+		newRv.Elem().Set(elemRv)
+		return newRv, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		if newRv, ok := convertNumber(rv, typ); ok {
+			return newRv, true
+		}
+	case reflect.Struct:
+		if rv.Kind() == reflect.Map {
+			return t.convertStruct(rv, typ)
+		}
+	case reflect.Map:
+		if rv.Kind() == reflect.Map {
+			return t.convertMap(rv, typ)
+		}
+	case reflect.Array, reflect.Slice:
+		if rv.Kind() == reflect.Array || rv.Kind() == reflect.Slice {
+			return t.convertElems(rv, typ)
+		}
+	}
+
+	// Named types of the same kind, such as a string into a named string type.
+	if rv.Kind() == typ.Kind() && rv.Type().ConvertibleTo(typ) {
+		return rv.Convert(typ), true
+	}
+	return reflect.Value{}, false
+}
+
+func (t *Traveller) convertStruct(rv reflect.Value, typ reflect.Type) (reflect.Value, bool) {
+	newRv := reflect.New(typ).Elem()
+	for it := rv.MapRange(); it.Next(); {
+		nameRv := it.Key()
+		if nameRv.Kind() == reflect.Interface {
+			nameRv = nameRv.Elem()
+		}
+		if nameRv.Kind() != reflect.String {
+			return reflect.Value{}, false
+		}
+
+		fieldRv, ok := t.fieldByName(newRv, nameRv.String())
+		if !ok || !fieldRv.CanSet() {
+			continue
+		}
+		valRv, ok := t.convert(it.Value(), fieldRv.Type())
+		if !ok {
+			return reflect.Value{}, false
+		}
+		fieldRv.Set(valRv)
+	}
+	return newRv, true
+}
+
+func (t *Traveller) convertMap(rv reflect.Value, typ reflect.Type) (reflect.Value, bool) {
+	if rv.IsNil() {
+		return reflect.Zero(typ), true
+	}
+
+	newRv := reflect.MakeMapWithSize(typ, rv.Len())
+	for it := rv.MapRange(); it.Next(); {
+		keyRv, ok := convertKey(it.Key().Interface(), typ.Key(), true)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		valRv, ok := t.convert(it.Value(), typ.Elem())
+		if !ok {
+			return reflect.Value{}, false
+		}
+		newRv.SetMapIndex(keyRv, valRv)
+	}
+	return newRv, true
+}
+
+func (t *Traveller) convertElems(rv reflect.Value, typ reflect.Type) (reflect.Value, bool) {
+	var newRv reflect.Value
+	if typ.Kind() == reflect.Slice {
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return reflect.Zero(typ), true
+		}
+		newRv = reflect.MakeSlice(typ, rv.Len(), rv.Len())
+	} else {
+		if rv.Len() != typ.Len() {
+			return reflect.Value{}, false
+		}
+		newRv = reflect.New(typ).Elem()
+	}
+
+	for i := 0; i < rv.Len(); i++ {
+		valRv, ok := t.convert(rv.Index(i), typ.Elem())
+		if !ok {
+			return reflect.Value{}, false
+		}
+		newRv.Index(i).Set(valRv)
+	}
+	return newRv, true
+}
+
+// Get the field of the struct by the name given by FieldName.
+// Fields of embedded structs are included unless NoFlatEmbeds is set.
+func (t *Traveller) fieldByName(rv reflect.Value, name string) (reflect.Value, bool) {
+	rt := rv.Type()
+	for i := 0; i < rv.NumField(); i++ {
+		if fieldName, ok := t.FieldName(rt.Field(i)); ok && fieldName == name {
+			return t.Field(rv, i), true
+		}
+	}
+	if t.noFlatEmbeds {
+		return reflect.Value{}, false
+	}
+
+	// Shallower fields take precedence over the fields of embedded structs.
+	for i := 0; i < rv.NumField(); i++ {
+		field := rt.Field(i)
+		if _, ok := t.FieldName(field); !ok || !field.Anonymous || field.Type.Kind() != reflect.Struct {
+			continue
+		}
+		if fieldRv, ok := t.fieldByName(t.Field(rv, i), name); ok {
+			return fieldRv, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// Convert a number into another numeric type if no information is lost.
+func convertNumber(rv reflect.Value, typ reflect.Type) (reflect.Value, bool) {
+	newRv := reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, ok := numberAsInt(rv)
+		if !ok || newRv.OverflowInt(i) {
+			return reflect.Value{}, false
+		}
+		newRv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, ok := numberAsUint(rv)
+		if !ok || newRv.OverflowUint(u) {
+			return reflect.Value{}, false
+		}
+		newRv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, ok := numberAsFloat(rv)
+		if !ok || newRv.OverflowFloat(f) {
+			return reflect.Value{}, false
+		}
+		newRv.SetFloat(f)
+	default:
+		return reflect.Value{}, false
+	}
+	return newRv, true
+}
+
+func numberAsInt(rv reflect.Value) (int64, bool) {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u := rv.Uint(); u <= math.MaxInt64 {
+			return int64(u), true
+		}
+	case reflect.Float32, reflect.Float64:
+		if f := rv.Float(); f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+			return int64(f), true
+		}
+	}
+	return 0, false
+}
+
+func numberAsUint(rv reflect.Value) (uint64, bool) {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i := rv.Int(); i >= 0 {
+			return uint64(i), true
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint(), true
+	case reflect.Float32, reflect.Float64:
+		if f := rv.Float(); f == math.Trunc(f) && f >= 0 && f < math.MaxUint64 {
+			return uint64(f), true
+		}
+	}
+	return 0, false
+}
+
+func numberAsFloat(rv reflect.Value) (float64, bool) {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if f := float64(rv.Int()); int64(f) == rv.Int() {
+			return f, true
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if f := float64(rv.Uint()); uint64(f) == rv.Uint() {
+			return f, true
+		}
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,83 @@
+package traveller
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+)
+
+var (
+	// The error that is returned when there is no value matching the path.
+	ErrNotFound = errors.New("value not found")
+
+	// The error that is returned when a value cannot be assigned to the matching path.
+	ErrUnassignable = errors.New("value unassignable")
+)
+
+// The result of copying a single path mapping.
+type CopyResult struct {
+	// The source path.
+	From string
+
+	// The destination path.
+	To string
+
+	// The reason the value was not copied. Nil if the value was copied.
+	Err error
+}
+
+// Copy values from `src` into `dst` using a mapping of source paths to destination paths.
+//
+// Each source path is read with Get semantics, taking the first matching value.
+// The value is written into the destination path with Upsert semantics.
+// Both paths are traversed with WithParseKeys, so that they can address indexes and map keys.
+//
+// A result is returned for each mapping, ordered by the source path.
+//
+// `dst` must be a pointer to a value or it will panic.
+func CopyPaths(dst, src any, mapping map[string]string, options ...TravellerOption) []CopyResult {
+	dstRv := settableRoot(dst)
+	srcRv := reflect.ValueOf(src)
+
+	options = append([]TravellerOption{WithParseKeys(true)}, options...)
+	froms := make([]string, 0, len(mapping))
+	for from := range mapping {
+		froms = append(froms, from)
+	}
+	sort.Strings(froms)
+
+	results := make([]CopyResult, 0, len(froms))
+	for _, from := range froms {
+		to := mapping[from]
+		results = append(results, CopyResult{
+			From: from,
+			To:   to,
+			Err:  copyPath(dstRv, srcRv, from, to, options),
+		})
+	}
+	return results
+}
+
+// Copy a single value from the source path into the destination path.
+func copyPath(dstRv, srcRv reflect.Value, from, to string, options []TravellerOption) error {
+	fromMp, err := Path(from, false)
+	if err != nil {
+		return err
+	}
+	toMp, err := Path(to, false)
+	if err != nil {
+		return err
+	}
+
+	val, ok := findFirst(srcRv, fromMp, options)
+	if !ok {
+		return ErrNotFound
+	}
+
+	count := 0
+	upsertBy(dstRv, toMp, func(any) (any, bool, bool) { return val, true, true }, &count, options)
+	if count == 0 {
+		return ErrUnassignable
+	}
+	return nil
+}
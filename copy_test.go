@@ -0,0 +1,38 @@
+package traveller_test
+
+import "github.com/ezraisw/traveller"
+
+func (s GeneralTestSuite) TestCallCopyPathsPanic() {
+	s.Panics(func() {
+		traveller.CopyPaths(chain{}, makeBulb(), map[string]string{})
+	})
+}
+
+func (s GeneralTestSuite) TestCallCopyPaths() {
+	dst := chain{}
+
+	results := traveller.CopyPaths(&dst, makeBulb(), map[string]string{
+		"Band":        "Name",
+		"Cup.Favour":  "Next.Name",
+		"Cup.Missing": "Name",
+		"Sunshine":    "Cache.sunshine",
+		"Band.**":     "Children.0.Name",
+		"***":         "Name",
+		"Worth":       "Name",
+	})
+
+	s.Equal([]traveller.CopyResult{
+		{From: "***", To: "Name", Err: traveller.ErrInvalidPath},
+		{From: "Band", To: "Name"},
+		{From: "Band.**", To: "Children.0.Name", Err: traveller.ErrNotFound},
+		{From: "Cup.Favour", To: "Next.Name"},
+		{From: "Cup.Missing", To: "Name", Err: traveller.ErrNotFound},
+		{From: "Sunshine", To: "Cache.sunshine"},
+		{From: "Worth", To: "Name", Err: traveller.ErrUnassignable},
+	}, results)
+	s.Equal(chain{
+		Name:  "dWoZA2QqGf9An6Ew25eC",
+		Next:  &chain{Name: "VL6foOIq436n8gevZi7K"},
+		Cache: map[string]any{"sunshine": 121},
+	}, dst)
+}
@@ -0,0 +1,159 @@
+package traveller
+
+import "reflect"
+
+// Delete all values matching the path.
+//
+// Map entries are removed and slice elements are removed while keeping the
+// order of the remaining elements. Struct fields and array elements cannot
+// be removed, so they are reset to their zero value instead. Keys of a
+// Traversable are only removed if it implements TraversableDeleter, which
+// includes *sync.Map and *list.List.
+//
+// `in` must be a pointer to a value or it will panic.
+func Delete(in any, mp []Matcher, options ...TravellerOption) int {
+	return deleteAll(settableRoot(in), mp, options)
+}
+
+// A traversed value that may have slice elements or Traversable keys removed from it.
+type deleteFrame struct {
+	containerRv reflect.Value
+	removed     map[int]struct{}
+
+	// The Traversable of the value and its keys to be removed.
+	tr          Traversable
+	removedKeys []any
+}
+
+// Delete all values matching the path starting from the given settable value.
+func deleteAll(inRv reflect.Value, mp []Matcher, options []TravellerOption) int {
+	count := 0
+
+	// Removal of slice elements and Traversable keys is deferred until all of
+	// their children have been traversed so that the indexes stay valid.
+	var frames []*deleteFrame
+
+	cb := TravellerCallback{
+		OnTraversal: func(t Traversal) bool {
+			// The found values are only removed from their parent.
+			if t.Index() == t.Traveller().PathLen() {
+				return t.Next(t.RV())
+			}
+
+			rv, wb, ok := settableValue(t)
+			if !ok {
+				return true
+			}
+
+			frame := &deleteFrame{containerRv: Unbox(rv)}
+			if tr, trRv, ok := asTraversable(rv); ok {
+				frame.containerRv, frame.tr = trRv, tr
+			}
+			frames = append(frames, frame)
+			return t.then(rv, func() {
+				frames = frames[:len(frames)-1]
+
+				if len(frame.removed) > 0 {
+					removeIndexes(rv, frame.removed)
+				}
+				// The keys are counted once they are actually removed.
+				for _, key := range frame.removedKeys {
+					if frame.tr.(TraversableDeleter).TravellerDelete(key) {
+						count++
+					}
+				}
+				wb.apply()
+			})
+		},
+		OnFound: func(f Found) bool {
+			if deleteFromParent(f.ParentRV(), f.Key(), frames) {
+				count++
+			}
+			return true // Keep searching.
+		},
+	}
+
+	StartTraversal(inRv, mp, cb, options...)
+	return count
+}
+
+// Delete the value of the key from the parent.
+// Slice elements and Traversable keys are marked on the frame of the parent to be removed later,
+// where only slice elements are reported as deleted right away.
+func deleteFromParent(parentRv reflect.Value, key any, frames []*deleteFrame) bool {
+	if isTraversable(parentRv) {
+		for i := len(frames) - 1; i >= 0; i-- {
+			frame := frames[i]
+			if frame.tr == nil || !sameReference(frame.containerRv, parentRv) {
+				continue
+			}
+			if _, ok := frame.tr.(TraversableDeleter); ok {
+				frame.removedKeys = append(frame.removedKeys, key)
+			}
+			break
+		}
+		return false
+	}
+
+	switch parentRv.Kind() {
+	case reflect.Struct:
+		if fieldRv := parentRv.FieldByName(key.(string)); fieldRv.CanSet() {
+			fieldRv.Set(reflect.Zero(fieldRv.Type()))
+			return true
+		}
+	case reflect.Map:
+		keyRv := key.(reflect.Value)
+		if parentRv.MapIndex(keyRv).IsValid() {
+			parentRv.SetMapIndex(keyRv, reflect.Value{})
+			return true
+		}
+	case reflect.Array:
+		if elemRv := parentRv.Index(key.(int)); elemRv.CanSet() {
+			elemRv.Set(reflect.Zero(elemRv.Type()))
+			return true
+		}
+	case reflect.Slice:
+		for i := len(frames) - 1; i >= 0; i-- {
+			frame := frames[i]
+			if !sameSlice(frame.containerRv, parentRv) {
+				continue
+			}
+			if frame.removed == nil {
+				frame.removed = make(map[int]struct{})
+			}
+			if _, ok := frame.removed[key.(int)]; ok {
+				return false
+			}
+			frame.removed[key.(int)] = struct{}{}
+			return true
+		}
+	}
+	return false
+}
+
+// Whether both values are the same slice.
+func sameSlice(rv1, rv2 reflect.Value) bool {
+	return rv1.Kind() == reflect.Slice && rv2.Kind() == reflect.Slice &&
+		rv1.Type() == rv2.Type() && rv1.Pointer() == rv2.Pointer() && rv1.Len() == rv2.Len()
+}
+
+// Whether both values are the same pointer or map.
+func sameReference(rv1, rv2 reflect.Value) bool {
+	switch rv1.Kind() {
+	case reflect.Ptr, reflect.Map:
+		return rv1.Type() == rv2.Type() && rv1.Pointer() == rv2.Pointer()
+	}
+	return false
+}
+
+// Remove the elements of the given indexes from the slice contained in rv.
+func removeIndexes(rv reflect.Value, removed map[int]struct{}) bool {
+	sliceRv := Unbox(rv)
+	newRv := reflect.MakeSlice(sliceRv.Type(), 0, sliceRv.Len()-len(removed))
+	for i := 0; i < sliceRv.Len(); i++ {
+		if _, ok := removed[i]; !ok {
+			newRv = reflect.Append(newRv, sliceRv.Index(i))
+		}
+	}
+	return assignContainer(rv, sliceRv, newRv)
+}
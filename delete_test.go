@@ -0,0 +1,61 @@
+package traveller_test
+
+import "github.com/ezraisw/traveller"
+
+func (s GeneralTestSuite) TestCallDeletePanic() {
+	s.Panics(func() {
+		traveller.Delete(makeBulb(), traveller.P("Band"))
+	})
+}
+
+func (s GeneralTestSuite) TestCallDeleteMapEntry() {
+	actual := makeBulb()
+	s.Equal(1, traveller.Delete(&actual, traveller.P("Cup.Houseplant.Mislead")))
+
+	expected := makeBulb()
+	delete(expected.Cup["Houseplant"].(map[string]string), "Mislead")
+	s.Equal(expected, actual)
+}
+
+func (s GeneralTestSuite) TestCallDeleteField() {
+	actual := makeBulb()
+	s.Equal(2, traveller.Delete(&actual, traveller.P("Federation.Hate.Slide.Consumption.P*")))
+
+	expected := makeBulb()
+	expected.Federation.Hate.Slide.Consumption = swipe{Meaning: "WXTy6UrwVwm4A2gt4gV8"}
+	s.Equal(expected, actual)
+}
+
+func (s GeneralTestSuite) TestCallDeleteSliceElements() {
+	actual := makeBulb()
+	parseKeys := traveller.WithParseKeys(true)
+	s.Equal(1, traveller.Delete(&actual, traveller.P("Federation.Clean.2"), parseKeys))
+	s.Equal(5, traveller.Delete(&actual, traveller.P("Federation.Hate.Critic.*")))
+	s.Equal(0, traveller.Delete(&actual, traveller.P("Federation.Clean.5"), parseKeys))
+	s.Equal(1, traveller.Delete(&actual, traveller.P("Federation.Hate.Create.Fence.Knowledge.Job.3"), parseKeys))
+
+	expected := makeBulb()
+	expected.Federation.Clean = []int{517, 440, 357, 871, 455}
+	expected.Federation.Hate.Critic = []int{}
+	job := expected.Federation.Hate.Create.Fence.Knowledge.Job.([]any)
+	expected.Federation.Hate.Create.Fence.Knowledge.Job = append(job[:3:3], job[4])
+	s.Equal(expected, actual)
+}
+
+func (s GeneralTestSuite) TestCallDeleteRecursive() {
+	actual := map[string]any{
+		"events": []any{
+			map[string]any{"name": "created", "secret": "a"},
+			map[string]any{"name": "updated", "secret": "b"},
+		},
+		"secret": "c",
+	}
+	s.Equal(2, traveller.Delete(&actual, traveller.P("**.secret")))
+	s.Equal(map[string]any{
+		"events": []any{
+			map[string]any{"name": "created"},
+			map[string]any{"name": "updated"},
+		},
+		"secret": "c",
+	}, actual)
+}
@@ -0,0 +1,302 @@
+package traveller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// The type of a change between two values.
+type ChangeType int
+
+const (
+	// The value only exists in the new value.
+	ChangeAdded ChangeType = iota + 1
+
+	// The value only exists in the old value.
+	ChangeRemoved
+
+	// The value exists in both, but is different.
+	ChangeModified
+)
+
+func (c ChangeType) String() string {
+	switch c {
+	case ChangeAdded:
+		return "added"
+	case ChangeRemoved:
+		return "removed"
+	case ChangeModified:
+		return "modified"
+	}
+	return fmt.Sprintf("ChangeType(%d)", int(c))
+}
+
+// A single difference between two values.
+type Change struct {
+	Type ChangeType
+
+	// The location of the changed value.
+	// Use Location.String for the path or Location.Matchers for the matchers.
+	Location Location
+
+	// The old value, nil for ChangeAdded.
+	From any
+
+	// The new value, nil for ChangeRemoved.
+	To any
+}
+
+// Obtain the structural differences between two values.
+//
+// Structs, maps, slices, arrays, and Traversable containers such as *sync.Map and *list.List
+// are compared recursively. Map entries, container keys, and slice elements that only exist
+// on one side are reported as added or removed. Every other difference, including values of
+// different types, is reported as modified. Pointers and interfaces are compared by the values
+// behind them. Cyclic values are compared until the comparison reaches a pair of values that
+// is already being compared.
+//
+// Struct fields are named the same way as in traversal, use WithTagName to name them by
+// their tags. Embedded structs are always part of the location. Values matching the paths
+// given through WithExclude in either value are not compared. Kinds ignored through
+// WithIgnoreStruct, WithIgnoreMap, and WithIgnoreArray are compared as a whole.
+//
+// Changes are ordered by location, with map keys sorted.
+func Diff(a, b any, options ...TravellerOption) []Change {
+	traveller := &Traveller{}
+	traveller.applyOptions(options)
+
+	aRv, bRv := reflect.ValueOf(a), reflect.ValueOf(b)
+
+	d := differ{
+		traveller: traveller,
+		comparing: make(map[diffRef]bool),
+	}
+	d.diff(aRv, bRv, &trail{excluded: traveller.findExcluded(aRv, bRv)})
+	return d.changes
+}
+
+// The identity of a pair of shared values that are compared.
+type diffRef struct {
+	a, b cloneRef
+}
+
+// Collector of changes between two values.
+type differ struct {
+	traveller *Traveller
+	changes   []Change
+
+	// The pairs of shared values that are being compared, used to stop on cyclic values.
+	comparing map[diffRef]bool
+}
+
+func (d *differ) add(typ ChangeType, tr *trail, from, to reflect.Value) {
+	d.changes = append(d.changes, Change{
+		Type:     typ,
+		Location: tr.location(),
+		From:     valueInterface(from),
+		To:       valueInterface(to),
+	})
+}
+
+func (d *differ) diff(aRv, bRv reflect.Value, tr *trail) {
+	if tr.isExcluded() {
+		return
+	}
+
+	if ref, ok := diffRefOf(aRv, bRv); ok {
+		if d.comparing[ref] {
+			return
+		}
+		d.comparing[ref] = true
+		defer delete(d.comparing, ref)
+	}
+
+	if aTr, ok := readTraversable(aRv); ok {
+		if bTr, ok := readTraversable(bRv); ok && reflect.TypeOf(aTr) == reflect.TypeOf(bTr) {
+			d.diffTraversable(aTr, bTr, tr)
+			return
+		}
+	}
+
+	aRv, bRv = Unbox(aRv), Unbox(bRv)
+	if !aRv.IsValid() || !bRv.IsValid() || aRv.Type() != bRv.Type() {
+		if aRv.IsValid() || bRv.IsValid() {
+			d.add(ChangeModified, tr, aRv, bRv)
+		}
+		return
+	}
+
+	switch aRv.Kind() {
+	case reflect.Struct:
+		if !d.traveller.IgnoreStruct() {
+			d.diffStruct(aRv, bRv, tr)
+			return
+		}
+	case reflect.Map:
+		if !d.traveller.IgnoreMap() {
+			d.diffMap(aRv, bRv, tr)
+			return
+		}
+	case reflect.Array, reflect.Slice:
+		if !d.traveller.IgnoreArray() {
+			d.diffArray(aRv, bRv, tr)
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(aRv.Interface(), bRv.Interface()) {
+		d.add(ChangeModified, tr, aRv, bRv)
+	}
+}
+
+func (d *differ) diffStruct(aRv, bRv reflect.Value, tr *trail) {
+	rt := aRv.Type()
+	for i := 0; i < aRv.NumField(); i++ {
+		name, ok := d.traveller.FieldName(rt.Field(i))
+		if !ok {
+			continue
+		}
+		d.diff(d.traveller.Field(aRv, i), d.traveller.Field(bRv, i), tr.child(name))
+	}
+}
+
+func (d *differ) diffMap(aRv, bRv reflect.Value, tr *trail) {
+	keys := aRv.MapKeys()
+	for _, keyRv := range bRv.MapKeys() {
+		if !aRv.MapIndex(keyRv).IsValid() {
+			keys = append(keys, keyRv)
+		}
+	}
+	d.traveller.sortMapKeys(keys)
+
+	for _, keyRv := range keys {
+		childTr := tr.child(keyRv)
+		if childTr.isExcluded() {
+			continue
+		}
+
+		aValueRv, bValueRv := aRv.MapIndex(keyRv), bRv.MapIndex(keyRv)
+		switch {
+		case !bValueRv.IsValid():
+			d.add(ChangeRemoved, childTr, Unbox(aValueRv), reflect.Value{})
+		case !aValueRv.IsValid():
+			d.add(ChangeAdded, childTr, reflect.Value{}, Unbox(bValueRv))
+		default:
+			d.diff(aValueRv, bValueRv, childTr)
+		}
+	}
+}
+
+func (d *differ) diffArray(aRv, bRv reflect.Value, tr *trail) {
+	for i := 0; i < aRv.Len() || i < bRv.Len(); i++ {
+		childTr := tr.child(i)
+		if childTr.isExcluded() {
+			continue
+		}
+
+		switch {
+		case i >= bRv.Len():
+			d.add(ChangeRemoved, childTr, Unbox(aRv.Index(i)), reflect.Value{})
+		case i >= aRv.Len():
+			d.add(ChangeAdded, childTr, reflect.Value{}, Unbox(bRv.Index(i)))
+		default:
+			d.diff(aRv.Index(i), bRv.Index(i), childTr)
+		}
+	}
+}
+
+// Compare the values of two Traversable containers of the same type like a map.
+// The keys of *sync.Map are sorted, while the keys of other containers are in the order
+// of the first container followed by the keys that only exist in the second one.
+func (d *differ) diffTraversable(aTr, bTr Traversable, tr *trail) {
+	keys := aTr.TravellerKeys()
+	for _, key := range bTr.TravellerKeys() {
+		if _, ok := aTr.TravellerGet(key); !ok {
+			keys = append(keys, key)
+		}
+	}
+	if _, ok := aTr.(syncMapTraversable); ok {
+		sort.SliceStable(keys, func(i, j int) bool {
+			return lessKey(reflect.ValueOf(keys[i]), reflect.ValueOf(keys[j]))
+		})
+	}
+
+	for _, key := range keys {
+		childTr := tr.child(key)
+		if childTr.isExcluded() {
+			continue
+		}
+
+		aVal, aOk := aTr.TravellerGet(key)
+		bVal, bOk := bTr.TravellerGet(key)
+		aValueRv, bValueRv := reflect.ValueOf(&aVal).Elem(), reflect.ValueOf(&bVal).Elem()
+		switch {
+		case !bOk:
+			d.add(ChangeRemoved, childTr, Unbox(aValueRv), reflect.Value{})
+		case !aOk:
+			d.add(ChangeAdded, childTr, reflect.Value{}, Unbox(bValueRv))
+		default:
+			d.diff(aValueRv, bValueRv, childTr)
+		}
+	}
+}
+
+// Obtain the identity of the pair of values if both are shared values of the same type,
+// which are non-nil pointers, maps, and slices.
+func diffRefOf(aRv, bRv reflect.Value) (diffRef, bool) {
+	aRef, ok := cloneRefOf(aRv)
+	if !ok {
+		return diffRef{}, false
+	}
+	bRef, ok := cloneRefOf(bRv)
+	if !ok || aRef.typ != bRef.typ {
+		return diffRef{}, false
+	}
+	return diffRef{a: aRef, b: bRef}, true
+}
+
+// Obtain the interface of the value, nil if the value is invalid.
+func valueInterface(rv reflect.Value) any {
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+// Sort map keys in a deterministic order.
+func sortKeys(keys []reflect.Value) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		return lessKey(keys[i], keys[j])
+	})
+}
+
+// Whether the first key is ordered before the second key.
+//
+// Keys of different kinds are ordered by their kind, while keys
+// that are not ordered by nature are compared by their formatted value.
+func lessKey(rv1, rv2 reflect.Value) bool {
+	if rv1.Kind() == reflect.Interface {
+		rv1 = rv1.Elem()
+	}
+	if rv2.Kind() == reflect.Interface {
+		rv2 = rv2.Elem()
+	}
+	if rv1.Kind() != rv2.Kind() {
+		return rv1.Kind() < rv2.Kind()
+	}
+
+	switch rv1.Kind() {
+	case reflect.String:
+		return rv1.String() < rv2.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv1.Int() < rv2.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv1.Uint() < rv2.Uint()
+	case reflect.Float32, reflect.Float64:
+		return rv1.Float() < rv2.Float()
+	case reflect.Bool:
+		return !rv1.Bool() && rv2.Bool()
+	}
+	return fmt.Sprint(valueInterface(rv1)) < fmt.Sprint(valueInterface(rv2))
+}
@@ -0,0 +1,122 @@
+package traveller_test
+
+import (
+	"reflect"
+
+	"github.com/ezraisw/traveller"
+)
+
+func (s GeneralTestSuite) TestCallDiff() {
+	a := makeBulb()
+	b := makeBulb()
+	b.Worth = b.Worth[:2]
+	b.Federation.Decline["Victory"] = "edited"
+	delete(b.Federation.Decline, "Instinct")
+	b.Federation.Decline["Triumph"] = "added"
+	b.Federation.Clean = append(b.Federation.Clean, 1)
+	b.Federation.Hate.Locate = 872
+	b.Federation.Hate.Slide.Carbon = "none"
+
+	changes := traveller.Diff(a, b)
+	s.Equal([]traveller.Change{
+		{Type: traveller.ChangeRemoved, Location: traveller.Location{"Worth", 2}, From: "RGnXLTPCadctoltAPnXs"},
+		{Type: traveller.ChangeRemoved, Location: traveller.Location{"Federation", "Decline", "Instinct"}, From: "8bJD76KwNbdBMZE6L1ex"},
+		{Type: traveller.ChangeAdded, Location: traveller.Location{"Federation", "Decline", "Triumph"}, To: "added"},
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Federation", "Decline", "Victory"}, From: "43ZeSUgDdanbNBemUydH", To: "edited"},
+		{Type: traveller.ChangeAdded, Location: traveller.Location{"Federation", "Clean", 6}, To: 1},
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Federation", "Hate", "Locate"}, From: 871, To: 872},
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Federation", "Hate", "Slide", "Carbon"}, From: 7000.42, To: "none"},
+	}, changes)
+
+	s.Equal("Federation.Clean.6", changes[4].Location.String())
+	s.Equal("added", changes[4].Type.String())
+
+	// Locations of existing values can be used as paths.
+	s.Equal(872, traveller.MustGet[int](b, traveller.P(changes[5].Location.String())))
+	s.Equal("added", traveller.MustGet[string](b, changes[2].Location.Matchers()))
+
+	s.Empty(traveller.Diff(makeBulb(), makeBulb()))
+}
+
+func (s GeneralTestSuite) TestCallDiffOptions() {
+	a := makeBulb()
+	b := makeBulb()
+	b.Federation.Jet.Party = "edited"
+	b.Federation.Jet.Tiger = "edited"
+	b.Federation.Decline["Victory"] = "edited"
+	b.Federation.Hate.Slide.Swipe["Deserted"] = swipe{Plain: "St1ABpJxt6l5ktcDnXs6", Meaning: "1qC401Uo5gXh2363Aqk2", Peace: 1}
+	b.Inheritance = 1
+
+	changes := traveller.Diff(a, b, traveller.WithTagName("json"))
+	s.Equal([]traveller.Change{
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Federation", "jet", "party"}, From: "ZPGANa8QAKvR7AFzXwCn", To: "edited"},
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Federation", "decline", "Victory"}, From: "43ZeSUgDdanbNBemUydH", To: "edited"},
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Federation", "Hate", "Slide", "Swipe", "Deserted", "peace"}, From: 99214, To: 1},
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Embedded", "inheritance"}, From: 9876, To: 1},
+	}, changes)
+
+	changes = traveller.Diff(a, b, traveller.WithIgnoreMap(true), traveller.WithExclude(traveller.P("**.Party")))
+	s.Equal([]traveller.Change{
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Federation", "Jet", "Tiger"}, From: "zJwMx0qTQaYWqKsOABNf", To: "edited"},
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Federation", "Decline"}, From: a.Federation.Decline, To: b.Federation.Decline},
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Federation", "Hate", "Slide", "Swipe"}, From: a.Federation.Hate.Slide.Swipe, To: b.Federation.Hate.Slide.Swipe},
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Embedded", "Inheritance"}, From: 9876, To: 1},
+	}, changes)
+}
+
+func (s GeneralTestSuite) TestCallDiffCyclic() {
+	shared := &chain{Name: "shared"}
+	a := &chain{Name: "a", Shared: shared, Children: []*chain{shared}}
+	a.Next = a
+	b := &chain{Name: "b", Shared: shared, Children: []*chain{shared}}
+	b.Next = b
+
+	s.Equal([]traveller.Change{
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Name"}, From: "a", To: "b"},
+	}, traveller.Diff(a, b))
+
+	// Shared values are compared at every location they appear in.
+	c := &chain{Name: "a", Shared: &chain{Name: "other"}}
+	c.Children = []*chain{c.Shared}
+	c.Next = c
+	s.Equal([]traveller.Change{
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Shared", "Name"}, From: "shared", To: "other"},
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Children", 0, "Name"}, From: "shared", To: "other"},
+	}, traveller.Diff(a, c))
+
+	m := map[string]any{"version": 1}
+	m["self"] = m
+	n := map[string]any{"version": 2}
+	n["self"] = n
+	s.Equal([]traveller.Change{
+		{Type: traveller.ChangeModified, Location: traveller.Location{"version"}, From: 1, To: 2},
+	}, traveller.Diff(m, n))
+}
+
+func (s GeneralTestSuite) TestCallGetWithTagName() {
+	in := makeBulb()
+	json := traveller.WithTagName("json")
+
+	s.Equal("ZPGANa8QAKvR7AFzXwCn", traveller.MustGet[string](in, traveller.P("Federation.jet.party"), json))
+	s.Equal([]int{696969, 99214, 999999}, traveller.GetAll[int](in, traveller.P("**.peace"), json))
+	s.Equal([]string{"ZPGANa8QAKvR7AFzXwCn"}, traveller.GetAll[string](in, traveller.P("Federation.jet.p*"), json))
+
+	_, ok := traveller.Get[string](in, traveller.P("Federation.jet.Tiger"), json)
+	s.False(ok)
+	_, ok = traveller.Get[string](in, traveller.P("Federation.Jet.Party"), json)
+	s.False(ok)
+
+	var locations []string
+	cb := traveller.TravellerCallback{
+		OnFound: func(f traveller.Found) bool {
+			locations = append(locations, f.Location().String())
+			return true
+		},
+	}
+	traveller.StartTraversal(reflect.ValueOf(in), traveller.P("**.peace"), cb, json)
+	s.Equal([]string{
+		"Federation.Hate.Create.Fence.Knowledge.Job.3.peace",
+		"Federation.Hate.Slide.Swipe.Deserted.peace",
+		"Federation.Hate.Slide.Consumption.peace",
+	}, locations)
+}
@@ -0,0 +1,140 @@
+package traveller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Flatten the value into a map of the leaf values keyed by their location as a string path.
+//
+// Leaf values are the values that are not traversed further, which are values other than
+// structs, maps, arrays, and slices, along with empty ones. The keys are rendered the same way
+// as Location.String, so that Path and Unflatten match them exactly.
+func Flatten(i any, options ...TravellerOption) map[string]any {
+	flat := make(map[string]any)
+
+	onFound := func(f Found) bool {
+		if f.Traveller().isLeaf(f.RV()) {
+			flat[f.Location().String()] = f.RV().Interface()
+		}
+		return true // Keep searching.
+	}
+
+	StartTraversal(reflect.ValueOf(i), P("**"), TravellerCallback{OnFound: onFound}, options...)
+	return flat
+}
+
+// Restore the values of a map given by Flatten into the given value.
+//
+// Each key is upserted as a path with WithParseKeys, creating the values that do not exist
+// yet. Values are converted into the type of their target as long as no information is lost.
+// Missing values in untyped containers are created as slices for numeric keys and as maps
+// otherwise.
+//
+// The values are restored on a deep copy of the value, which is only assigned back on success.
+//
+// `into` must be a pointer to a value or it will panic.
+func Unflatten(flat map[string]any, into any, options ...TravellerOption) error {
+	inRv := settableRoot(into)
+
+	options = append([]TravellerOption{WithParseKeys(true)}, options...)
+	traveller := &Traveller{}
+	traveller.applyOptions(options)
+
+	workRv := reflect.New(inRv.Type()).Elem()
+	workRv.Set(deepCopy(inRv))
+
+	keys := make([]string, 0, len(flat))
+	for key := range flat {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := traveller.unflatten(workRv, key, flat[key], options); err != nil {
+			return err
+		}
+	}
+
+	inRv.Set(workRv)
+	return nil
+}
+
+// Upsert the value of the flattened key into rv.
+func (t *Traveller) unflatten(rv reflect.Value, key string, val any, options []TravellerOption) error {
+	mp, err := Path(key, false)
+	if err != nil {
+		return fmt.Errorf("unflatten %q: %w", key, err)
+	}
+
+	var (
+		count    = 0
+		upsertMp = make([]Matcher, len(mp))
+	)
+	for i, m := range mp {
+		exact, ok := m.(MatchExact)
+		if !ok {
+			return fmt.Errorf("unflatten %q: %w", key, ErrInvalidPath)
+		}
+		upsertMp[i] = matchUnflatten{matchUpsert: matchUpsert{MatchExact: exact, count: &count}}
+	}
+
+	cb := TravellerCallback{
+		OnTraversal: handleInaddrVals,
+		OnFound: func(f Found) bool {
+			if valRv, ok := t.convertValue(val, f.RV().Type()); ok && f.RV().CanSet() {
+				f.RV().Set(valRv)
+				count++
+			}
+			return count == 0 // Only restore into the first match.
+		},
+	}
+
+	StartTraversal(rv, upsertMp, cb, options...)
+	if count == 0 {
+		return fmt.Errorf("unflatten %q: %w", key, ErrUnassignable)
+	}
+	return nil
+}
+
+// Upsert match that creates slices for numeric keys in untyped containers.
+type matchUnflatten struct {
+	matchUpsert
+}
+
+// Compile-time implementation check.
+var _ Matcher = (*matchUnflatten)(nil)
+
+func (m matchUnflatten) Match(rv reflect.Value, s MatcherSegment) bool {
+	if _, ok := indexKey(m.Value, true); ok && rv.Kind() == reflect.Interface && rv.IsNil() && rv.CanSet() {
+		if sliceRv := reflect.ValueOf([]any{}); sliceRv.Type().AssignableTo(rv.Type()) {
+			rv.Set(sliceRv)
+		}
+	}
+	return m.matchUpsert.Match(rv, s)
+}
+
+// Whether the value is not traversed further, such as scalars and empty containers.
+func (t *Traveller) isLeaf(rv reflect.Value) bool {
+	if tr, _, ok := asTraversable(rv); ok {
+		return len(tr.TravellerKeys()) == 0
+	}
+
+	switch rv := Unbox(rv); rv.Kind() {
+	case reflect.Struct:
+		if t.ignoreStruct {
+			return true
+		}
+		for i := 0; i < rv.NumField(); i++ {
+			if _, ok := t.FieldName(rv.Type().Field(i)); ok {
+				return false
+			}
+		}
+	case reflect.Map:
+		return t.ignoreMap || rv.Len() == 0
+	case reflect.Array, reflect.Slice:
+		return t.ignoreArray || rv.Len() == 0
+	}
+	return true
+}
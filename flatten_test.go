@@ -0,0 +1,78 @@
+package traveller_test
+
+import (
+	"errors"
+
+	"github.com/ezraisw/traveller"
+)
+
+func (s GeneralTestSuite) TestCallFlatten() {
+	in := makeBulb()
+
+	s.Equal(map[string]any{
+		"party":          "ZPGANa8QAKvR7AFzXwCn",
+		"barrel.Outside": 34,
+		"barrel.Pumpkin": 420,
+		"retirement":     69.999,
+	}, traveller.Flatten(in.Federation.Jet, traveller.WithTagName("json")))
+
+	untyped := map[string]any{"a": []any{1, map[string]any{"b": "c"}}, "d*": nil, "e.f": []any{}, `g\*h`: "i"}
+	s.Equal(map[string]any{
+		"a.0":    1,
+		"a.1.b":  "c",
+		`d\*`:    nil,
+		`e\.f`:   []any{},
+		`g\\\*h`: "i",
+	}, traveller.Flatten(untyped))
+
+	// The keys are paths to the values they are flattened from.
+	s.Len(traveller.Flatten(in), 112)
+	for _, in := range []any{in, untyped} {
+		for key, val := range traveller.Flatten(in) {
+			s.Equal(val, traveller.MustGet[any](in, traveller.P(key), traveller.WithParseKeys(true)), key)
+		}
+	}
+}
+
+func (s GeneralTestSuite) TestCallUnflattenRoundTrip() {
+	withJSON := traveller.WithTagName("json")
+
+	in := makeBulb()
+	in.Sunshine = 1
+	in.Cup["Favour"] = "edited"
+	in.Federation.Jet.Barrel.(map[string]int)["Pumpkin"] = 1
+	in.Federation.Hate.Create.Fence.Knowledge.Job.([]any)[3] = swipe{Plain: "edited"}
+
+	out := makeBulb()
+	s.Require().NoError(traveller.Unflatten(traveller.Flatten(in, withJSON), &out, withJSON))
+	s.Equal(in, out)
+
+	untyped := map[string]any{"a": []any{1, map[string]any{"b": "c"}}, "d*": "e", `g\*h`: "i"}
+	var outUntyped any
+	s.Require().NoError(traveller.Unflatten(traveller.Flatten(untyped), &outUntyped))
+	s.Equal(untyped, outUntyped)
+}
+
+func (s GeneralTestSuite) TestCallUnflattenInto() {
+	out := makeBulb()
+
+	err := traveller.Unflatten(map[string]any{
+		"Sunshine":           float64(3306),
+		"Federation.Clean.6": float64(1),
+		"Cup.team":           "core",
+		`Cup.a\*`:            "literal",
+	}, &out)
+	s.Require().NoError(err)
+	s.Equal(3306, out.Sunshine)
+	s.Equal([]int{517, 440, 168, 357, 871, 455, 1}, out.Federation.Clean)
+	s.Equal("core", out.Cup["team"])
+	s.Equal("literal", out.Cup["a*"])
+
+	// Nothing is restored when any of the values fails.
+	err = traveller.Unflatten(map[string]any{"Band": "other", "Sunshine": "high"}, &out)
+	s.True(errors.Is(err, traveller.ErrUnassignable))
+	s.Equal("dWoZA2QqGf9An6Ew25eC", out.Band)
+
+	err = traveller.Unflatten(map[string]any{"Cup.*": "x"}, &out)
+	s.True(errors.Is(err, traveller.ErrInvalidPath))
+}
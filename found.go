@@ -37,6 +37,12 @@ func (f Found) Key() any {
 	return f.key
 }
 
+// Get the location of the found value from the root.
+// Only available until the callback returns.
+func (f Found) Location() Location {
+	return f.traveller.location()
+}
+
 // The callback on each found value.
 //
 // Return true to continue traversal.
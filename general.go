@@ -21,7 +21,7 @@ type SetterFunc[T any] func(oldVal T) (newVal any, keepSearching, shouldSet bool
 //
 // Will panic if there is no match.
 func MustGet[T any](i any, mp []Matcher, options ...TravellerOption) T {
-	val, ok := Get[T](i, mp)
+	val, ok := Get[T](i, mp, options...)
 	if !ok {
 		panic(panicMsgNoMatch)
 	}
@@ -38,7 +38,7 @@ func Get[T any](i any, mp []Matcher, options ...TravellerOption) (T, bool) {
 	)
 
 	onFound := func(f Found) bool {
-		if val, ok = f.RV().Interface().(T); ok {
+		if val, ok = interfaceAs[T](f.RV()); ok {
 			return false // Stop searching on first match.
 		}
 		return true // Keep searching.
@@ -49,16 +49,15 @@ func Get[T any](i any, mp []Matcher, options ...TravellerOption) (T, bool) {
 }
 
 // Get all value of type T, matching path.
+//
+// The traversal is split between goroutines when WithParallelism is set.
 func GetAll[T any](i any, mp []Matcher, options ...TravellerOption) []T {
-	vals := make([]T, 0)
-
-	onFound := func(f Found) bool {
-		vals = appendOnTypeMatch(vals, f.RV())
-		return true // Keep searching.
+	onFound := func(f Found) (T, bool, bool) {
+		val, ok := interfaceAs[T](f.RV())
+		return val, ok, true // Keep searching.
 	}
 
-	StartTraversal(reflect.ValueOf(i), mp, TravellerCallback{OnFound: onFound}, options...)
-	return vals
+	return findAll(reflect.ValueOf(i), mp, onFound, options)
 }
 
 // Set a single value matching the path using the given value.
@@ -80,17 +79,13 @@ func Set(in any, mp []Matcher, val any, options ...TravellerOption) bool {
 //
 // `in` must be a pointer to a value or it will panic.
 func SetBy[T any](in any, mp []Matcher, setter SetterFunc[T], options ...TravellerOption) bool {
-	inRv := reflect.ValueOf(in)
-	if inRv.Kind() != reflect.Ptr {
-		panic(panicMsgNotAPointerForSet)
-	}
-	inRv = inRv.Elem()
+	inRv := settableRoot(in)
 
 	changed := false
 	cb := TravellerCallback{
 		OnTraversal: handleInaddrVals,
 		OnFound: func(f Found) bool {
-			oldVal, ok := f.RV().Interface().(T)
+			oldVal, ok := interfaceAs[T](f.RV())
 			if !ok {
 				return true // Keep searching.
 			}
@@ -100,11 +95,8 @@ func SetBy[T any](in any, mp []Matcher, setter SetterFunc[T], options ...Travell
 				return keepSearching // Keep searching.
 			}
 
-			newRv := reflect.ValueOf(newVal)
-
 			// Only set compatible types.
-			if rv := f.RV(); newRv.Type().AssignableTo(rv.Type()) {
-				rv.Set(newRv)
+			if rv := f.RV(); setAssignable(rv, newVal) {
 				changed = true
 			}
 
@@ -133,17 +125,18 @@ func SetAll(in any, mp []Matcher, val any, options ...TravellerOption) int {
 //
 // `in` must be a pointer to a value or it will panic.
 func SetAllBy[T any](in any, mp []Matcher, setter SetterFunc[T], options ...TravellerOption) int {
-	inRv := reflect.ValueOf(in)
-	if inRv.Kind() != reflect.Ptr {
-		panic(panicMsgNotAPointerForSet)
-	}
-	inRv = inRv.Elem()
-
 	count := 0
+	setAllBy(settableRoot(in), mp, setter, &count, options)
+	return count
+}
+
+// Set all values matching the path and type starting from the given settable value.
+// Every successful assignment increments count.
+func setAllBy[T any](inRv reflect.Value, mp []Matcher, setter SetterFunc[T], count *int, options []TravellerOption) {
 	cb := TravellerCallback{
 		OnTraversal: handleInaddrVals,
 		OnFound: func(f Found) bool {
-			oldVal, ok := f.RV().Interface().(T)
+			oldVal, ok := interfaceAs[T](f.RV())
 			if !ok {
 				return true // Keep searching.
 			}
@@ -153,12 +146,9 @@ func SetAllBy[T any](in any, mp []Matcher, setter SetterFunc[T], options ...Trav
 				return keepSearching
 			}
 
-			newRv := reflect.ValueOf(newVal)
-
 			// Only set compatible types.
-			if rv := f.RV(); newRv.Type().AssignableTo(rv.Type()) {
-				rv.Set(newRv)
-				count++
+			if rv := f.RV(); setAssignable(rv, newVal) {
+				*count++
 			}
 
 			return keepSearching
@@ -166,14 +156,54 @@ func SetAllBy[T any](in any, mp []Matcher, setter SetterFunc[T], options ...Trav
 	}
 
 	StartTraversal(inRv, mp, cb, options...)
-	return count
 }
 
-func appendOnTypeMatch[T any](slice []T, rv reflect.Value) []T {
-	if v, ok := rv.Interface().(T); ok {
-		slice = append(slice, v)
+// Obtain the settable value behind the given pointer.
+//
+// Will panic if `in` is not a pointer.
+func settableRoot(in any) reflect.Value {
+	inRv := reflect.ValueOf(in)
+	if inRv.Kind() != reflect.Ptr {
+		panic(panicMsgNotAPointerForSet)
+	}
+	return inRv.Elem()
+}
+
+// Obtain val as a reflect.Value that can be assigned to the given type.
+// A nil val results in the zero value of types that can hold nil.
+func assignableValue(val any, typ reflect.Type) (reflect.Value, bool) {
+	if val == nil {
+		switch typ.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			return reflect.Zero(typ), true
+		}
+		return reflect.Value{}, false
+	}
+	valRv := reflect.ValueOf(val)
+	if !valRv.Type().AssignableTo(typ) {
+		return reflect.Value{}, false
+	}
+	return valRv, true
+}
+
+// Set val into rv only if it is assignable.
+func setAssignable(rv reflect.Value, val any) bool {
+	valRv, ok := assignableValue(val, rv.Type())
+	if !ok {
+		return false
+	}
+	rv.Set(valRv)
+	return true
+}
+
+// Obtain the value of rv as type T.
+// A nil interface value is considered as the zero value of T if T is an interface.
+func interfaceAs[T any](rv reflect.Value) (T, bool) {
+	val, ok := rv.Interface().(T)
+	if !ok && rv.Kind() == reflect.Interface && rv.IsNil() {
+		ok = reflect.TypeOf(&val).Elem().Kind() == reflect.Interface
 	}
-	return slice
+	return val, ok
 }
 
 // Whether it is a mutable type but is stack allocated by default.
@@ -189,8 +219,46 @@ func mutableInaddr(rv reflect.Value) bool {
 
 // The handler for handling nested inaddressable values.
 func handleInaddrVals(t Traversal) bool {
-	if t.RV().CanAddr() && !mutableInaddr(t.RV()) {
-		return t.Next(t.RV())
+	rv, wb, ok := settableValue(t)
+	if !ok {
+		return true
+	}
+
+	keepSearching, deferred := t.nextDeferred(rv)
+	switch {
+	case !wb.needed():
+	case deferred:
+		t.Traveller().deferAfter(wb.apply)
+	default:
+		wb.apply()
+	}
+	return keepSearching
+}
+
+// The assignment of a value substituted by settableValue back to its parent.
+type writeBack struct {
+	t             Traversal
+	newRv, hackRv reflect.Value
+}
+
+// Obtain a value that is guaranteed to be settable to continue the traversal with.
+// Inaddressable values are substituted with a copy, which must be assigned back to its parent
+// through the returned writeBack once everything inside the value is visited.
+// False is returned if the value cannot be modified.
+func settableValue(t Traversal) (reflect.Value, writeBack, bool) {
+	// Read-only unexported fields cannot be modified.
+	if isReadOnlyField(t) {
+		return reflect.Value{}, writeBack{}, false
+	}
+
+	// Neither can decoded JSON content that is not encoded back.
+	if t.Index() != t.Traveller().PathLen() && t.Traveller().isReadOnlyRawJSON(t.RV()) {
+		return reflect.Value{}, writeBack{}, false
+	}
+
+	// Children of a Traversable are copies which always need to be assigned back.
+	if t.RV().CanAddr() && !mutableInaddr(t.RV()) && !isTraversable(t.ParentRV()) {
+		return t.RV(), writeBack{}, true
 	}
 
 	// Workaround for things that return inaddressable values.
@@ -218,24 +286,61 @@ func handleInaddrVals(t Traversal) bool {
 		newRv.Set(t.RV())
 	}
 
-	keepSearching := t.Next(newRv)
+	return newRv, writeBack{t: t, newRv: newRv, hackRv: hackRv}, true
+}
+
+// Whether the value was substituted and needs to be assigned back.
+func (wb writeBack) needed() bool {
+	return wb.newRv.IsValid()
+}
+
+// Assign the substituted value back to its parent, if any.
+func (wb writeBack) apply() {
+	if !wb.needed() {
+		return
+	}
 
 	// Restore the hack so that the types are the same.
 	// Only when mutableInaddr() returns true and is not the found value.
-	if hackRv.IsValid() {
-		newRv.Set(hackRv.Elem())
+	if wb.hackRv.IsValid() {
+		wb.newRv.Set(wb.hackRv.Elem())
 	}
 
-	setForParent(t.ParentRV(), t.Key(), newRv)
+	// Without a parent, the traversal started on this value.
+	if t := wb.t; !t.ParentRV().IsValid() && t.RV().CanSet() {
+		t.RV().Set(wb.newRv)
+	} else {
+		t.Traveller().setForParent(t.ParentRV(), t.Key(), wb.newRv)
+	}
+}
 
-	return keepSearching
+// Whether the value is an unexported field that cannot be set.
+func isReadOnlyField(t Traversal) bool {
+	if !t.Traveller().Unexported() || t.Traveller().UnexportedWritable() || t.ParentRV().Kind() != reflect.Struct {
+		return false
+	}
+	name, _ := t.Key().(string)
+	field, ok := t.ParentRV().Type().FieldByName(name)
+	return ok && !field.IsExported()
 }
 
-func setForParent(parentRv reflect.Value, key any, newRv reflect.Value) {
+// Assign the value to the key of its parent.
+// Read-only unexported fields are left as is.
+func (t *Traveller) setForParent(parentRv reflect.Value, key any, newRv reflect.Value) {
+	if tr, ok := traversableOf(parentRv); ok {
+		tr.TravellerSet(key, newRv.Interface())
+		return
+	}
+
 	switch parentRv.Kind() {
 	case reflect.Struct:
-		fieldName := key.(string)
-		parentRv.FieldByName(fieldName).Set(newRv)
+		field, ok := parentRv.Type().FieldByName(key.(string))
+		if !ok {
+			return
+		}
+		if fieldRv := t.Field(parentRv, field.Index[0]); fieldRv.CanSet() {
+			fieldRv.Set(newRv)
+		}
 	case reflect.Map:
 		keyRv := key.(reflect.Value)
 		parentRv.SetMapIndex(keyRv, newRv)
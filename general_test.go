@@ -10,17 +10,19 @@ import (
 )
 
 type swipe struct {
-	Plain   string
-	Meaning string
-	Peace   int
+	Plain   string `json:"plain"`
+	Meaning string `json:"meaning,omitempty"`
+	Peace   int    `json:"peace"`
 }
 
 type facade struct {
-	Party      string
-	Barrel     any
-	Retirement float64
-	Tiger      string
+	Party      string  `json:"party"`
+	Barrel     any     `json:"barrel"`
+	Retirement float64 `json:"retirement"`
+	Tiger      string  `json:"-"`
 	unexported string
+	hidden     swipe
+	lining     any
 }
 
 type offroad struct {
@@ -56,7 +58,7 @@ type mode struct {
 }
 
 type Embedded struct {
-	Inheritance int
+	Inheritance int `json:"inheritance"`
 }
 
 type open struct {
@@ -89,9 +91,9 @@ type speech struct {
 }
 
 type habit struct {
-	Jet      facade
-	Decline  map[string]string
-	Clean    []int
+	Jet      facade            `json:"jet"`
+	Decline  map[string]string `json:"decline"`
+	Clean    []int             `json:"clean"`
 	Elephant []string
 	Hate     speech
 }
@@ -135,6 +137,12 @@ func makeBulb() bulb {
 				Retirement: 69.999,
 				Tiger:      "zJwMx0qTQaYWqKsOABNf",
 				unexported: "EmzONvxGZb0hc2MQK9Bu",
+				hidden: swipe{
+					Plain:   "Qm8RzT1vKc0pXy4LwN2e",
+					Meaning: "Hs5dGj7UaE3bVo9iFt6q",
+					Peace:   3,
+				},
+				lining: []string{"Zr4nWq8LpX2cVb7MkT1y", "Pe6JhS3uDf9GaY0oRi5x"},
 			},
 			Decline: map[string]string{
 				"Victory":  "43ZeSUgDdanbNBemUydH",
@@ -234,6 +242,14 @@ func makeBulb() bulb {
 	}
 }
 
+type chain struct {
+	Name     string
+	Next     *chain
+	Shared   *chain
+	Children []*chain
+	Cache    map[string]any
+}
+
 type generalSubTestCase interface {
 	DoTest(*assert.Assertions)
 }
@@ -242,12 +258,17 @@ type getAllSubTestCase[I any, T any] struct {
 	in       I
 	mp       []traveller.Matcher
 	expected []T
+	ordered  bool
 	options  []traveller.TravellerOption
 }
 
 func (c getAllSubTestCase[I, T]) DoTest(assert *assert.Assertions) {
 	actual := traveller.GetAll[T](c.in, c.mp, c.options...)
-	assert.ElementsMatch(c.expected, actual)
+	if c.ordered {
+		assert.Equal(c.expected, actual)
+	} else {
+		assert.ElementsMatch(c.expected, actual)
+	}
 }
 
 type getSubTestCase[I any, T any] struct {
@@ -468,6 +489,18 @@ func (s GeneralTestSuite) TestCallGet() {
 	}
 }
 
+func (s GeneralTestSuite) TestCallGetParseKeys() {
+	x := makeBulb()
+
+	_, ok := traveller.Get[string](x, traveller.P("Brother.0"))
+	s.False(ok)
+	s.Equal(x.Brother[0], traveller.MustGet[string](x, traveller.P("Brother.0"), traveller.WithParseKeys(true)))
+
+	_, ok = traveller.Get[string](map[int]string{7: "seven"}, traveller.P("7"))
+	s.False(ok)
+	s.Equal("seven", traveller.MustGet[string](map[int]string{7: "seven"}, traveller.P("7"), traveller.WithParseKeys(true)))
+}
+
 func (s GeneralTestSuite) TestCallSetAllPanic() {
 	s.Panics(func() {
 		traveller.SetAll(69, []traveller.Matcher{}, 0)
@@ -43,3 +43,82 @@ func AssumeAsString(rv reflect.Value) (str string, ok bool) {
 	}
 	return
 }
+
+// Convert a key into a value of the given type for addressing map entries.
+//
+// With parse, strings are parsed into numeric and boolean types and keys are converted
+// into named types of the same kind. Otherwise, the key must be assignable.
+func convertKey(key any, typ reflect.Type, parse bool) (reflect.Value, bool) {
+	keyRv := reflect.ValueOf(key)
+	if !keyRv.IsValid() {
+		return reflect.Value{}, false
+	}
+	if keyRv.Type().AssignableTo(typ) {
+		return keyRv, true
+	}
+	if !parse {
+		return reflect.Value{}, false
+	}
+	if keyRv.Kind() == reflect.String {
+		return parseString(keyRv.String(), typ)
+	}
+	if keyRv.Kind() == typ.Kind() && keyRv.Type().ConvertibleTo(typ) {
+		return keyRv.Convert(typ), true
+	}
+	return reflect.Value{}, false
+}
+
+// Parse a string into a value of the given type.
+//
+// Only string, int, uint, float, and bool kinds are supported.
+func parseString(str string, typ reflect.Type) (reflect.Value, bool) {
+	rv := reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.String:
+		rv.SetString(str)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(str, 10, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		rv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(str, 10, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		rv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(str, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		rv.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(str)
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		rv.SetBool(b)
+	default:
+		return reflect.Value{}, false
+	}
+	return rv, true
+}
+
+// Obtain a key as an array/slice index.
+//
+// With parse, strings of an int are accepted as well.
+func indexKey(key any, parse bool) (int, bool) {
+	switch v := key.(type) {
+	case int:
+		return v, v >= 0
+	case string:
+		if !parse {
+			return 0, false
+		}
+		i, err := strconv.Atoi(v)
+		return i, err == nil && i >= 0
+	}
+	return 0, false
+}
@@ -0,0 +1,199 @@
+package traveller
+
+import "reflect"
+
+// Return a copy of `in` where all values matching the path are set using the given value.
+// Will only assign the value if it is assignable to the matching field.
+//
+// The original value is left untouched. See UpdateAll for more details.
+func With[T any](in T, mp []Matcher, val any, options ...TravellerOption) T {
+	out, _ := UpdateAll(in, mp, func(any) (any, bool, bool) { return val, true, true }, options...)
+	return out
+}
+
+// Return a copy of `in` where all values matching the path and type are set using a function.
+// The `setter` behaves the same as the one in SetAllBy.
+//
+// The original value is left untouched, making it safe to use on values that are shared.
+// Only the values along the modified paths are copied (structurally shared copy),
+// every other value including pointers, maps, and slices is shared with the original.
+// Built-in containers such as *sync.Map and *list.List are rebuilt when their values are modified,
+// while values inside custom Traversable containers are not modified, as they cannot be copied.
+func UpdateAll[T, V any](in T, mp []Matcher, setter SetterFunc[V], options ...TravellerOption) (T, int) {
+	inRv := reflect.ValueOf(&in).Elem()
+
+	count := 0
+
+	// The root frame receives the modified copy of the value itself.
+	frames := []*copyFrame{{}}
+
+	cb := TravellerCallback{
+		OnTraversal: func(t Traversal) bool {
+			// Read-only unexported fields cannot be modified.
+			if isReadOnlyField(t) {
+				return true
+			}
+			// Custom Traversable containers cannot be copied.
+			if parentRv := t.ParentRV(); isTraversable(parentRv) && !isContainerType(parentRv.Type()) {
+				return true
+			}
+			parent := frames[len(frames)-1]
+
+			// Use the copy of an earlier visit so that its modifications are not lost.
+			rv := t.RV()
+			if prevRv, ok := parent.get(t.Key()); ok {
+				rv = prevRv
+			}
+
+			frame := &copyFrame{}
+			frames = append(frames, frame)
+			return t.then(rv, func() {
+				frames = frames[:len(frames)-1]
+
+				if newRv, ok := frame.apply(t.Traveller(), rv); ok {
+					parent.set(t.Key(), newRv)
+				}
+			})
+		},
+		OnFound: func(f Found) bool {
+			oldVal, ok := interfaceAs[V](f.RV())
+			if !ok {
+				return true // Keep searching.
+			}
+
+			newVal, keepSearching, shouldSet := setter(oldVal)
+			if !shouldSet {
+				return keepSearching
+			}
+
+			// Only set compatible types.
+			if valRv, ok := assignableValue(newVal, f.RV().Type()); ok {
+				newRv := reflect.New(f.RV().Type()).Elem()
+				newRv.Set(valRv)
+				frames[len(frames)-1].replacedRv = newRv
+				count++
+			}
+
+			return keepSearching
+		},
+	}
+
+	StartTraversal(inRv, mp, cb, options...)
+
+	if newRv, ok := frames[0].get(nil); ok {
+		outRv := reflect.New(inRv.Type())
+		outRv.Elem().Set(newRv)
+		return *outRv.Interface().(*T), count
+	}
+	return in, count
+}
+
+// The modifications made on a single traversed value.
+type copyFrame struct {
+	// The new value replacing the traversed value entirely.
+	replacedRv reflect.Value
+
+	// The new values of the children, in order of modification.
+	keys    []any
+	changes map[any]reflect.Value
+}
+
+// Get the modified child of the given key.
+func (c *copyFrame) get(key any) (reflect.Value, bool) {
+	rv, ok := c.changes[locationKey(key)]
+	return rv, ok
+}
+
+// Set the modified child of the given key.
+func (c *copyFrame) set(key any, rv reflect.Value) {
+	if c.changes == nil {
+		c.changes = make(map[any]reflect.Value)
+	}
+	ck := locationKey(key)
+	if _, ok := c.changes[ck]; !ok {
+		c.keys = append(c.keys, key)
+	}
+	c.changes[ck] = rv
+}
+
+// Obtain the modified copy of rv, if there are any modifications.
+func (c *copyFrame) apply(t *Traveller, rv reflect.Value) (reflect.Value, bool) {
+	if c.replacedRv.IsValid() {
+		return c.replacedRv, true
+	}
+	if len(c.keys) == 0 {
+		return reflect.Value{}, false
+	}
+
+	if rv.Kind() == reflect.Interface {
+		newRv := reflect.New(rv.Type()).Elem()
+		newRv.Set(c.applyIndirect(t, rv.Elem()))
+		return newRv, true
+	}
+	return c.applyIndirect(t, rv), true
+}
+
+// Copy the pointer along with the value it points to.
+func (c *copyFrame) applyIndirect(t *Traveller, rv reflect.Value) reflect.Value {
+	if rv.Kind() == reflect.Ptr {
+		newRv := reflect.New(rv.Type().Elem())
+		newRv.Elem().Set(c.applyContainer(t, rv.Elem()))
+		return newRv
+	}
+	return c.applyContainer(t, rv)
+}
+
+// Shallow copy the container and set the modified children on the copy.
+// Unexported fields are set through Traveller.Field, as the copy is addressable.
+func (c *copyFrame) applyContainer(t *Traveller, rv reflect.Value) reflect.Value {
+	if isContainerType(rv.Type()) {
+		return c.applyTraversable(rv)
+	}
+
+	var newRv reflect.Value
+	switch rv.Kind() {
+	case reflect.Map:
+		newRv = reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		for it := rv.MapRange(); it.Next(); {
+			newRv.SetMapIndex(it.Key(), it.Value())
+		}
+	case reflect.Slice:
+		newRv = reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		reflect.Copy(newRv, rv)
+	default:
+		newRv = reflect.New(rv.Type()).Elem()
+		newRv.Set(rv)
+	}
+
+	for _, key := range c.keys {
+		changeRv := c.changes[locationKey(key)]
+		switch newRv.Kind() {
+		case reflect.Struct:
+			if field, ok := newRv.Type().FieldByName(key.(string)); ok {
+				t.Field(newRv, field.Index[0]).Set(changeRv)
+			}
+		case reflect.Map:
+			newRv.SetMapIndex(key.(reflect.Value), changeRv)
+		case reflect.Array, reflect.Slice:
+			newRv.Index(key.(int)).Set(changeRv)
+		}
+	}
+	return newRv
+}
+
+// Rebuild the built-in container with the same values and set the modified children on the copy.
+func (c *copyFrame) applyTraversable(rv reflect.Value) reflect.Value {
+	srcRv := rv
+	if !srcRv.CanAddr() {
+		srcRv = reflect.New(rv.Type()).Elem()
+		srcRv.Set(rv)
+	}
+	newRv := reflect.New(rv.Type()).Elem()
+	copyContainer(newRv.Addr().Interface(), srcRv.Addr().Interface())
+
+	tr, _ := traversableOf(newRv.Addr())
+	for _, key := range c.keys {
+		tr.TravellerSet(key, c.changes[locationKey(key)].Interface())
+	}
+	return newRv
+}
@@ -0,0 +1,89 @@
+package traveller_test
+
+import (
+	"reflect"
+
+	"github.com/ezraisw/traveller"
+)
+
+func (s GeneralTestSuite) TestCallWith() {
+	in := makeBulb()
+	out := traveller.With(in, traveller.P("Cup.Houseplant.Mislead"), "edited")
+
+	s.Equal(makeBulb(), in)
+
+	expected := makeBulb()
+	expected.Cup["Houseplant"] = map[string]string{
+		"Mislead":   "edited",
+		"Machinery": "nnGbiSSEYt01kotPuVHS",
+	}
+	s.Equal(expected, out)
+
+	// Unmodified values are shared.
+	s.NotEqual(reflect.ValueOf(in.Cup).Pointer(), reflect.ValueOf(out.Cup).Pointer())
+	s.Equal(reflect.ValueOf(in.Worth).Pointer(), reflect.ValueOf(out.Worth).Pointer())
+	s.Equal(reflect.ValueOf(in.Federation.Clean).Pointer(), reflect.ValueOf(out.Federation.Clean).Pointer())
+	s.Equal(reflect.ValueOf(in.Federation.Decline).Pointer(), reflect.ValueOf(out.Federation.Decline).Pointer())
+	s.Equal(reflect.ValueOf(in.Federation.Jet.Barrel).Pointer(), reflect.ValueOf(out.Federation.Jet.Barrel).Pointer())
+}
+
+func (s GeneralTestSuite) TestCallWithNested() {
+	in := makeBulb()
+	out := traveller.With(in, traveller.P("Federation.Hate.Slide.Consumption.Plain"), "edited")
+
+	s.Equal(makeBulb(), in)
+
+	expected := makeBulb()
+	expected.Federation.Hate.Slide.Consumption = swipe{
+		Plain:   "edited",
+		Meaning: "WXTy6UrwVwm4A2gt4gV8",
+		Peace:   999999,
+	}
+	s.Equal(expected, out)
+}
+
+func (s GeneralTestSuite) TestCallWithNoMatch() {
+	in := makeBulb()
+	out := traveller.With(in, traveller.P("Nonexistent"), "edited")
+	s.Equal(in, out)
+	s.Equal(reflect.ValueOf(in.Cup).Pointer(), reflect.ValueOf(out.Cup).Pointer())
+}
+
+func (s GeneralTestSuite) TestCallWithAny() {
+	var in any = map[string]any{"a": []any{1, 2}}
+	out := traveller.With(in, traveller.P("a.1"), 3, traveller.WithParseKeys(true))
+	s.Equal(map[string]any{"a": []any{1, 2}}, in)
+	s.Equal(map[string]any{"a": []any{1, 3}}, out)
+}
+
+func (s GeneralTestSuite) TestCallUpdateAll() {
+	in := makeBulb()
+	out, count := traveller.UpdateAll(in, traveller.P("**.Peace"), func(oldVal int) (any, bool, bool) {
+		return oldVal * 2, true, true
+	})
+	s.Equal(3, count)
+	s.Equal(makeBulb(), in)
+
+	expected := makeBulb()
+	traveller.SetAllBy(&expected, traveller.P("**.Peace"), func(oldVal int) (any, bool, bool) {
+		return oldVal * 2, true, true
+	})
+	s.Equal([]int{696969 * 2, 99214 * 2, 999999 * 2}, traveller.GetAll[int](out, traveller.P("**.Peace")))
+	s.Equal(expected, out)
+}
+
+func (s GeneralTestSuite) TestCallUpdateAllBulb() {
+	editStr := " edited"
+	in := makeBulb()
+	out, count := traveller.UpdateAll(in, traveller.P("**"), func(oldVal string) (any, bool, bool) {
+		return oldVal + editStr, true, true
+	})
+	s.Equal(50, count)
+	s.Equal(makeBulb(), in)
+
+	expected := makeBulb()
+	traveller.SetAllBy(&expected, traveller.P("**"), func(oldVal string) (any, bool, bool) {
+		return oldVal + editStr, true, true
+	})
+	s.Equal(expected, out)
+}
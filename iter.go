@@ -0,0 +1,25 @@
+//go:build go1.23
+
+package traveller
+
+import (
+	"iter"
+	"reflect"
+)
+
+// Iterate over the values of type T matching the path, along with their location.
+//
+// The values are found as the iteration goes, so breaking out of the loop stops the traversal.
+func All[T any](i any, mp []Matcher, options ...TravellerOption) iter.Seq2[Location, T] {
+	return func(yield func(Location, T) bool) {
+		onFound := func(f Found) bool {
+			val, ok := interfaceAs[T](f.RV())
+			if !ok {
+				return true // Keep searching.
+			}
+			return yield(f.Location(), val)
+		}
+
+		StartTraversal(reflect.ValueOf(i), mp, TravellerCallback{OnFound: onFound}, options...)
+	}
+}
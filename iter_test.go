@@ -0,0 +1,29 @@
+//go:build go1.23
+
+package traveller_test
+
+import "github.com/ezraisw/traveller"
+
+func (s GeneralTestSuite) TestCallAll() {
+	var (
+		locations []string
+		vals      []string
+	)
+	for location, val := range traveller.All[string](makeBulb(), traveller.P("Worth.*")) {
+		locations = append(locations, location.String())
+		vals = append(vals, val)
+	}
+	s.Equal([]string{"Worth.0", "Worth.1", "Worth.2"}, locations)
+	s.Equal(makeBulb().Worth, vals)
+}
+
+func (s GeneralTestSuite) TestCallAllBreak() {
+	visited := 0
+	for _, v := range traveller.All[int](makeBulb(), traveller.P("Federation.Clean.*")) {
+		visited++
+		if v < 500 {
+			break
+		}
+	}
+	s.Equal(2, visited)
+}
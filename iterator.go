@@ -0,0 +1,89 @@
+package traveller
+
+import "reflect"
+
+// Pull-style iterator over the values of type T matching the path, for when
+// range-over-func iterators from All are not available.
+//
+// The traversal is driven by Next, which visits the pending matches iteratively until
+// the next value is found. Nothing runs in the background, therefore an iterator that is
+// not exhausted can simply be abandoned. Close releases the pending matches early.
+type Iterator[T any] struct {
+	traveller *Traveller
+
+	// The values found by the last visit that are not returned yet.
+	found []iteratorResult[T]
+	done  bool
+
+	location Location
+	val      T
+}
+
+// A value found by the traversal of an Iterator.
+type iteratorResult[T any] struct {
+	location Location
+	val      T
+}
+
+// Create an iterator over the values of type T matching the path.
+// The values are visited as Next is called.
+func NewIterator[T any](i any, mp []Matcher, options ...TravellerOption) *Iterator[T] {
+	it := &Iterator[T]{}
+
+	onFound := func(f Found) bool {
+		if val, ok := interfaceAs[T](f.RV()); ok {
+			it.found = append(it.found, iteratorResult[T]{location: f.Location(), val: val})
+		}
+		return true // Keep searching.
+	}
+
+	rv := reflect.ValueOf(i)
+	t := &Traveller{
+		mp: mp,
+		cb: TravellerCallback{OnFound: onFound},
+	}
+	t.applyOptions(options)
+	t.excluded = t.findExcluded(rv)
+
+	t.iterating, t.stepwise = true, true
+	t.pending = append(t.pending, pendingMatch{rv: rv, trail: t.root()})
+	it.traveller = t
+	return it
+}
+
+// Advance to the next value.
+//
+// False is returned when there are no more values.
+func (it *Iterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+	for len(it.found) == 0 {
+		if len(it.traveller.pending) == 0 {
+			it.Close()
+			return false
+		}
+		it.traveller.advance()
+	}
+
+	result := it.found[0]
+	it.found = it.found[1:]
+	it.location, it.val = result.location, result.val
+	return true
+}
+
+// Get the location of the current value.
+func (it *Iterator[T]) Location() Location {
+	return it.location
+}
+
+// Get the current value.
+func (it *Iterator[T]) Value() T {
+	return it.val
+}
+
+// Stop the traversal, releasing the matches that are not visited yet.
+func (it *Iterator[T]) Close() {
+	it.done = true
+	it.traveller, it.found = nil, nil
+}
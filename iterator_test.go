@@ -0,0 +1,66 @@
+package traveller_test
+
+import (
+	"runtime"
+
+	"github.com/ezraisw/traveller"
+)
+
+func (s GeneralTestSuite) TestCallIterator() {
+	in := []any{"a", 1, "b", []any{"c"}}
+
+	it := traveller.NewIterator[string](in, traveller.P("**"))
+	defer it.Close()
+
+	var (
+		locations []string
+		vals      []string
+	)
+	for it.Next() {
+		locations = append(locations, it.Location().String())
+		vals = append(vals, it.Value())
+	}
+	s.Equal([]string{"0", "2", "3.0"}, locations)
+	s.Equal([]string{"a", "b", "c"}, vals)
+	s.False(it.Next())
+}
+
+func (s GeneralTestSuite) TestCallIteratorClose() {
+	in := []any{"a", "b", "c"}
+
+	it := traveller.NewIterator[string](in, traveller.P("*"))
+	s.True(it.Next())
+	s.Equal("a", it.Value())
+	it.Close()
+	s.False(it.Next())
+
+	// Closing an iterator that has not started does nothing.
+	it = traveller.NewIterator[string](in, traveller.P("*"))
+	it.Close()
+	s.False(it.Next())
+}
+
+func (s GeneralTestSuite) TestCallIteratorAbandoned() {
+	in := []any{"a", []any{"b", []any{"c"}}}
+
+	// Abandoned iterators leave nothing running.
+	goroutines := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		it := traveller.NewIterator[string](in, traveller.P("**"))
+		s.True(it.Next())
+	}
+	s.Equal(goroutines, runtime.NumGoroutine())
+}
+
+func (s GeneralTestSuite) TestCallIteratorStrategy() {
+	in := []any{[]any{[]any{"c"}, "b"}, "a"}
+
+	for _, strategy := range []traveller.Strategy{traveller.DepthFirst, traveller.BreadthFirst} {
+		var vals []string
+		it := traveller.NewIterator[string](in, traveller.P("**"), traveller.WithStrategy(strategy))
+		for it.Next() {
+			vals = append(vals, it.Value())
+		}
+		s.Equal(traveller.GetAll[string](in, traveller.P("**"), traveller.WithStrategy(strategy)), vals)
+	}
+}
@@ -0,0 +1,450 @@
+// Package json evaluates traveller paths directly over JSON data.
+//
+// The data is read as a stream of tokens, skipping the values that cannot match
+// and decoding only the matched values.
+package json
+
+import (
+	"bytes"
+	stdjson "encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/ezraisw/traveller"
+	"github.com/gertd/wild"
+)
+
+var unmarshalerType = reflect.TypeOf((*stdjson.Unmarshaler)(nil)).Elem()
+
+// The error that is returned when the data continues after the top-level value.
+var ErrTrailingData = errors.New("json: invalid data after top-level value")
+
+// Get first value of type T matching the path from JSON data.
+//
+// The second value will be false if there is no match of path and type.
+// An error is returned if the data is not valid JSON. The data after the first match is
+// still read to be validated, but values are no longer decoded.
+func Get[T any](data []byte, mp []traveller.Matcher, options ...traveller.TravellerOption) (T, bool, error) {
+	var val T
+	vals, err := query[T](data, mp, options, 1)
+	if err != nil || len(vals) == 0 {
+		return val, false, err
+	}
+	return vals[0], true, nil
+}
+
+// Get all values of type T matching the path from JSON data.
+//
+// Matched values that cannot be decoded into T are skipped.
+// An error is returned if the data is not valid JSON.
+//
+// The built-in matchers are evaluated while reading, except filters and negative indexes
+// which need the whole array or object. Those, along with custom matchers, fall back to
+// decoding the value they are matching into `any` and traversing it.
+// WithIgnoreMap and WithIgnoreArray apply to JSON objects and arrays respectively.
+// Exact string segments such as "0" only select array elements with WithParseKeys,
+// the same as when traversing the decoded value.
+func GetAll[T any](data []byte, mp []traveller.Matcher, options ...traveller.TravellerOption) ([]T, error) {
+	return query[T](data, mp, options, 0)
+}
+
+// Find the matching values, keeping only up to the given limit if it is positive.
+func query[T any](data []byte, mp []traveller.Matcher, options []traveller.TravellerOption, limit int) ([]T, error) {
+	t := &traveller.Traveller{}
+	for _, option := range options {
+		option(t)
+	}
+
+	w := &walker[T]{
+		data:      data,
+		mp:        mp,
+		options:   options,
+		traveller: t,
+		limit:     limit,
+	}
+
+	dec := stdjson.NewDecoder(bytes.NewReader(data))
+	if err := w.walk(dec, []int{0}); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		if err == nil {
+			err = ErrTrailingData
+		}
+		return nil, err
+	}
+	return w.values(), nil
+}
+
+// A value found by the walker.
+type slot[T any] struct {
+	val T
+
+	// Whether the value is only decoded once the reading of it ends,
+	// as it is found before the values found inside of it.
+	pending bool
+
+	// Whether the value has been decoded into T.
+	ok bool
+}
+
+// Reader of JSON values that tracks the path segments matching each value.
+type walker[T any] struct {
+	data      []byte
+	mp        []traveller.Matcher
+	options   []traveller.TravellerOption
+	traveller *traveller.Traveller
+	limit     int
+	slots     []slot[T]
+
+	// The number of leading slots that are no longer pending, and how many of them are values.
+	settled int
+	count   int
+
+	// Whether enough values are found, so the rest is only read to be validated.
+	done bool
+}
+
+// Read the next value, where states are the indexes of the path segments matching it.
+func (w *walker[T]) walk(dec *stdjson.Decoder, states []int) error {
+	if w.done || len(states) == 0 {
+		return dec.Decode(&skipper{})
+	}
+
+	found := states[len(states)-1] == len(w.mp)
+	if found {
+		states = states[:len(states)-1]
+	}
+	if len(states) == 0 {
+		var val T
+		return w.add(dec.Decode(&val), val)
+	}
+
+	// Values that are both found and descended into are decoded from the bytes that have
+	// been read once the reading of them ends.
+	var (
+		start = dec.InputOffset()
+		index = -1
+	)
+	if found {
+		index = w.reserve()
+	}
+
+	var streamStates, fallbackStates []int
+	for _, state := range states {
+		if streamable(w.mp[state]) {
+			streamStates = append(streamStates, state)
+		} else {
+			fallbackStates = append(fallbackStates, state)
+		}
+	}
+
+	if err := w.walkChildren(dec, streamStates); err != nil {
+		return err
+	}
+	raw := bytes.TrimLeft(w.data[start:dec.InputOffset()], " \t\r\n,:")
+
+	if found {
+		if err := w.resolve(index, raw); err != nil {
+			return err
+		}
+	}
+	if len(fallbackStates) == 0 || w.done {
+		return nil
+	}
+
+	var decoded any
+	if err := stdjson.Unmarshal(raw, &decoded); err != nil {
+		return err
+	}
+	for _, state := range fallbackStates {
+		if err := w.fallback(decoded, w.mp[state:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Read the children of the next value with the given states.
+func (w *walker[T]) walkChildren(dec *stdjson.Decoder, states []int) error {
+	if len(states) == 0 {
+		return dec.Decode(&skipper{})
+	}
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	switch tok {
+	case stdjson.Delim('{'):
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key := keyTok.(string)
+
+			var childStates []int
+			if !w.traveller.IgnoreMap() {
+				childStates = w.childStates(states, key, -1)
+			}
+			if err := w.walk(dec, childStates); err != nil {
+				return err
+			}
+		}
+		_, err = dec.Token()
+	case stdjson.Delim('['):
+		for i := 0; dec.More(); i++ {
+			var childStates []int
+			if !w.traveller.IgnoreArray() {
+				childStates = w.childStates(states, strconv.Itoa(i), i)
+			}
+			if err := w.walk(dec, childStates); err != nil {
+				return err
+			}
+		}
+		_, err = dec.Token()
+	}
+	return err
+}
+
+// Traverse the decoded value using the remaining path.
+func (w *walker[T]) fallback(decoded any, mp []traveller.Matcher) error {
+	var err error
+	cb := traveller.TravellerCallback{
+		OnFound: func(f traveller.Found) bool {
+			if val, ok := f.RV().Interface().(T); ok {
+				err = w.add(nil, val)
+			} else {
+				err = w.convert(f.RV().Interface())
+			}
+			return err == nil && !w.done
+		},
+	}
+
+	traveller.StartTraversal(reflect.ValueOf(&decoded).Elem(), mp, cb, w.options...)
+	return err
+}
+
+// Convert a decoded value into T by encoding it again.
+func (w *walker[T]) convert(v any) error {
+	data, err := stdjson.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var val T
+	return w.add(stdjson.Unmarshal(data, &val), val)
+}
+
+// Add the decoded value, skipping values that are not of type T.
+func (w *walker[T]) add(err error, val T) error {
+	if isTypeError(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	w.slots = append(w.slots, slot[T]{val: val, ok: true})
+	w.settle()
+	return nil
+}
+
+// Reserve a slot for a value that is decoded once the reading of it ends.
+func (w *walker[T]) reserve() int {
+	w.slots = append(w.slots, slot[T]{pending: true})
+	return len(w.slots) - 1
+}
+
+// Decode the value of a reserved slot from the bytes that have been read.
+func (w *walker[T]) resolve(index int, raw []byte) error {
+	s := &w.slots[index]
+	s.pending = false
+
+	if !w.done && decodable[T](raw) {
+		err := stdjson.Unmarshal(raw, &s.val)
+		if err != nil && !isTypeError(err) {
+			return err
+		}
+		s.ok = err == nil
+	}
+	w.settle()
+	return nil
+}
+
+// Count the leading slots that are no longer pending, stopping once the limit is reached.
+func (w *walker[T]) settle() {
+	for !w.done && w.settled < len(w.slots) && !w.slots[w.settled].pending {
+		if w.slots[w.settled].ok {
+			w.count++
+		}
+		w.settled++
+		w.done = w.limit > 0 && w.count >= w.limit
+	}
+}
+
+// Obtain the decoded values in order, up to the limit.
+func (w *walker[T]) values() []T {
+	vals := make([]T, 0, w.count)
+	for _, s := range w.slots {
+		if w.limit > 0 && len(vals) == w.limit {
+			break
+		}
+		if s.ok {
+			vals = append(vals, s.val)
+		}
+	}
+	return vals
+}
+
+// Obtain the states of a child from the states of its parent.
+// The index is negative for object keys.
+func (w *walker[T]) childStates(states []int, key string, index int) []int {
+	var childStates []int
+	add := func(state int) {
+		for _, s := range childStates {
+			if s == state {
+				return
+			}
+		}
+		childStates = append(childStates, state)
+	}
+
+	for _, state := range states {
+		if state == len(w.mp) {
+			continue
+		}
+		switch m := w.mp[state].(type) {
+		case traveller.MatchMulti:
+			add(state)
+			add(state + 1)
+		case traveller.MatchDescendant:
+			add(state)
+			if selects(m.Matcher, key, index, w.traveller.ParseKeys()) {
+				add(state + 1)
+			}
+		default:
+			if selects(m, key, index, w.traveller.ParseKeys()) {
+				add(state + 1)
+			}
+		}
+	}
+
+	// Keep the states sorted so that the found state is the last.
+	sort.Ints(childStates)
+	return childStates
+}
+
+// Whether the error is caused by a value that does not fit the type.
+func isTypeError(err error) bool {
+	var typeErr *stdjson.UnmarshalTypeError
+	return errors.As(err, &typeErr)
+}
+
+// Whether the JSON value may be decoded into T without decoding it.
+// Objects and arrays are never decoded into scalars unless T decodes itself.
+func decodable[T any](raw []byte) bool {
+	if len(raw) == 0 || (raw[0] != '{' && raw[0] != '[') {
+		return true
+	}
+
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if reflect.PtrTo(typ).Implements(unmarshalerType) {
+		return true
+	}
+	switch typ.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return false
+	}
+	return true
+}
+
+// Whether the matcher can be evaluated while reading.
+func streamable(m traveller.Matcher) bool {
+	switch m := m.(type) {
+	case traveller.MatchExact, traveller.MatchPattern, traveller.MatchMulti:
+		return true
+	case traveller.MatchIndex:
+		return m.Index >= 0
+	case traveller.MatchSlice:
+		return m.Step >= 0 && (m.Start == nil || *m.Start >= 0) && (m.End == nil || *m.End >= 0)
+	case traveller.MatchUnion:
+		for _, m := range m.Matchers {
+			switch m.(type) {
+			case traveller.MatchMulti, traveller.MatchDescendant:
+				return false
+			}
+			if !streamable(m) {
+				return false
+			}
+		}
+		return true
+	case traveller.MatchDescendant:
+		return streamable(m.Matcher)
+	}
+	return false
+}
+
+// Whether the streamable matcher selects the child of the key.
+// The index is negative for object keys.
+//
+// Like the traversal of decoded values, strings of exact matchers only select array
+// elements with WithParseKeys, while ints only select array elements.
+func selects(m traveller.Matcher, key string, index int, parseKeys bool) bool {
+	switch m := m.(type) {
+	case traveller.MatchExact:
+		if index < 0 {
+			return m.Value == key
+		}
+		switch v := m.Value.(type) {
+		case int:
+			return v == index
+		case string:
+			i, err := strconv.Atoi(v)
+			return parseKeys && err == nil && i == index
+		}
+	case traveller.MatchPattern:
+		if index >= 0 && m.OnlyStringKey {
+			return false
+		}
+		return wild.Match(m.Pattern, key, m.CaseInsensitive)
+	case traveller.MatchIndex:
+		return index >= 0 && m.Index == index
+	case traveller.MatchSlice:
+		if index < 0 {
+			return false
+		}
+		step := m.Step
+		if step == 0 {
+			step = 1
+		}
+		start := 0
+		if m.Start != nil {
+			start = *m.Start
+		}
+		return index >= start && (m.End == nil || index < *m.End) && (index-start)%step == 0
+	case traveller.MatchUnion:
+		for _, m := range m.Matchers {
+			if selects(m, key, index, parseKeys) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Decoding target that discards the value.
+type skipper struct{}
+
+func (*skipper) UnmarshalJSON([]byte) error {
+	return nil
+}
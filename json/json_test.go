@@ -0,0 +1,164 @@
+package json_test
+
+import (
+	stdjson "encoding/json"
+	"testing"
+
+	"github.com/ezraisw/traveller"
+	"github.com/ezraisw/traveller/json"
+	"github.com/stretchr/testify/suite"
+)
+
+const document = `{
+	"id": "evt-1",
+	"source": {"host": "a.example.com", "port": 8080},
+	"items": [
+		{"sku": "A1", "qty": 2, "tags": ["new"]},
+		{"sku": "B2", "qty": 5, "tags": []},
+		{"sku": "C3", "qty": 1, "meta": {"sku": "nested"}}
+	],
+	"payload": {"large": [1, 2, 3, {"deep": {"deeper": true}}]}
+}`
+
+type item struct {
+	SKU string `json:"sku"`
+	Qty int    `json:"qty"`
+}
+
+type JSONTestSuite struct {
+	suite.Suite
+}
+
+func TestRunJSONTestSuite(t *testing.T) {
+	suite.Run(t, new(JSONTestSuite))
+}
+
+func (s JSONTestSuite) TestCallGetAll() {
+	skus, err := json.GetAll[string]([]byte(document), traveller.P("items.*.sku"))
+	s.NoError(err)
+	s.Equal([]string{"A1", "B2", "C3"}, skus)
+
+	skus, err = json.GetAll[string]([]byte(document), traveller.P("**.sku"))
+	s.NoError(err)
+	s.Equal([]string{"A1", "B2", "C3", "nested"}, skus)
+
+	qtys, err := json.GetAll[int]([]byte(document), traveller.P("items.1*.qty"))
+	s.NoError(err)
+	s.Equal([]int{5}, qtys)
+
+	items, err := json.GetAll[item]([]byte(document), traveller.P("items.*"))
+	s.NoError(err)
+	s.Equal([]item{{"A1", 2}, {"B2", 5}, {"C3", 1}}, items)
+
+	// Values of other types are skipped.
+	strs, err := json.GetAll[string]([]byte(document), traveller.P("source.*"))
+	s.NoError(err)
+	s.Equal([]string{"a.example.com"}, strs)
+
+	all, err := json.GetAll[bool]([]byte(document), traveller.P("**"))
+	s.NoError(err)
+	s.Equal([]bool{true}, all)
+
+	none, err := json.GetAll[string]([]byte(document), traveller.P("items.*.sku"), traveller.WithIgnoreArray(true))
+	s.NoError(err)
+	s.Empty(none)
+}
+
+func (s JSONTestSuite) TestCallGetAllJSONPath() {
+	skus, err := json.GetAll[string]([]byte(document), traveller.MustJSONPath("$.items[-1].sku"))
+	s.NoError(err)
+	s.Equal([]string{"C3"}, skus)
+
+	skus, err = json.GetAll[string]([]byte(document), traveller.MustJSONPath("$.items[?@.qty > 1].sku"))
+	s.NoError(err)
+	s.Equal([]string{"A1", "B2"}, skus)
+
+	skus, err = json.GetAll[string]([]byte(document), traveller.MustJSONPath("$..sku"))
+	s.NoError(err)
+	s.Equal([]string{"A1", "B2", "C3", "nested"}, skus)
+
+	qtys, err := json.GetAll[int]([]byte(document), traveller.MustJSONPath("$.items[0:3:2]['qty','missing']"))
+	s.NoError(err)
+	s.Equal([]int{2, 1}, qtys)
+}
+
+func (s JSONTestSuite) TestCallGetAllNumericSegments() {
+	var decoded any
+	s.Require().NoError(stdjson.Unmarshal([]byte(document), &decoded))
+
+	paths := map[string][]traveller.Matcher{
+		"path":           traveller.P("items.1.sku"),
+		"pointer":        traveller.MustPointer("/items/1/sku"),
+		"jsonpath name":  traveller.MustJSONPath("$.items['1'].sku"),
+		"jsonpath index": traveller.MustJSONPath("$.items[1].sku"),
+	}
+	for name, mp := range paths {
+		// Strings only select array elements with WithParseKeys, same as for decoded values.
+		expected := []string{}
+		if name == "jsonpath index" {
+			expected = []string{"B2"}
+		}
+		skus, err := json.GetAll[string]([]byte(document), mp)
+		s.NoError(err, name)
+		s.ElementsMatch(expected, skus, name)
+		s.ElementsMatch(expected, traveller.GetAll[string](decoded, mp), name)
+
+		skus, err = json.GetAll[string]([]byte(document), mp, traveller.WithParseKeys(true))
+		s.NoError(err, name)
+		s.Equal([]string{"B2"}, skus, name)
+		s.Equal([]string{"B2"}, traveller.GetAll[string](decoded, mp, traveller.WithParseKeys(true)), name)
+	}
+
+	// Numeric object keys are matched as they are.
+	vals, err := json.GetAll[string]([]byte(`{"0": "zero", "1": ["one"]}`), traveller.P("0"))
+	s.NoError(err)
+	s.Equal([]string{"zero"}, vals)
+}
+
+func (s JSONTestSuite) TestCallGet() {
+	host, ok, err := json.Get[string]([]byte(document), traveller.P("source.host"))
+	s.NoError(err)
+	s.True(ok)
+	s.Equal("a.example.com", host)
+
+	_, ok, err = json.Get[string]([]byte(document), traveller.P("source.missing"))
+	s.NoError(err)
+	s.False(ok)
+
+	// The data after the first match is still validated.
+	_, ok, err = json.Get[string]([]byte(`{"id": "x", "rest": [}`), traveller.P("id"))
+	s.Error(err)
+	s.False(ok)
+
+	_, _, err = json.Get[int]([]byte(`{"a": 1} garbage`), traveller.P("a"))
+	s.Error(err)
+
+	_, _, err = json.Get[int]([]byte(`{"a": 1} {"a": 2}`), traveller.P("a"))
+	s.ErrorIs(err, json.ErrTrailingData)
+
+	// Values are found before the values inside of them.
+	first, ok, err := json.Get[any]([]byte(document), traveller.P("payload.**"))
+	s.NoError(err)
+	s.True(ok)
+	s.Equal([]any{1.0, 2.0, 3.0, map[string]any{"deep": map[string]any{"deeper": true}}}, first)
+}
+
+func (s JSONTestSuite) TestCallGetAllNested() {
+	vals, err := json.GetAll[any]([]byte(`{"a": {"b": [1, {"c": 2}]}}`), traveller.P("a.**"))
+	s.NoError(err)
+	s.Equal([]any{
+		[]any{1.0, map[string]any{"c": 2.0}},
+		1.0,
+		map[string]any{"c": 2.0},
+		2.0,
+	}, vals)
+
+	nums, err := json.GetAll[float64]([]byte(`{"a": {"b": [1, {"c": 2}]}}`), traveller.P("**"))
+	s.NoError(err)
+	s.Equal([]float64{1, 2}, nums)
+
+	// Filters are evaluated on the same value that is read for the other segments.
+	skus, err := json.GetAll[string]([]byte(document), traveller.MustJSONPath("$.items[?@.qty > 1,1].sku"))
+	s.NoError(err)
+	s.ElementsMatch([]string{"A1", "B2", "B2"}, skus)
+}
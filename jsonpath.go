@@ -0,0 +1,568 @@
+package traveller
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Convert a JSONPath (RFC 9535) query to a series of matchers.
+//
+// Will panic if the given query is invalid.
+// Use JSONPath if an invalid input is expected.
+func MustJSONPath(query string) []Matcher {
+	mp, err := JSONPath(query)
+	if err != nil {
+		panic(err)
+	}
+	return mp
+}
+
+// Convert a JSONPath (RFC 9535) query to a series of matchers.
+//
+// The supported subset consists of the root identifier ($), child segments (.name, ['name'], [*]),
+// descendant segments (..name, ..*, ..[...]), indexes, slices, unions, and filters.
+// Filters support existence tests, comparisons, logical operators, and parentheses, where the
+// queries inside filters must be relative (@). Function extensions are not supported.
+//
+// Index and slice selectors always select array/slice elements. As with P, name selectors
+// such as ['0'] only select them with WithParseKeys. Member names are matched against struct
+// field names, use WithTagName("json") to match the names of the json tags instead.
+func JSONPath(query string) ([]Matcher, error) {
+	p := &jsonPathParser{in: query}
+	if !p.consume('$') {
+		return nil, ErrInvalidPath
+	}
+	mp, err := p.parseSegments()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.in) {
+		return nil, ErrInvalidPath
+	}
+	return mp, nil
+}
+
+// Recursive descent parser of JSONPath queries.
+type jsonPathParser struct {
+	in  string
+	pos int
+}
+
+// Get the current character, 0 at the end of the input.
+func (p *jsonPathParser) peek() byte {
+	if p.pos < len(p.in) {
+		return p.in[p.pos]
+	}
+	return 0
+}
+
+// Advance if the input continues with the given characters.
+func (p *jsonPathParser) consume(chars ...byte) bool {
+	if !strings.HasPrefix(p.in[p.pos:], string(chars)) {
+		return false
+	}
+	p.pos += len(chars)
+	return true
+}
+
+func (p *jsonPathParser) skipSpaces() {
+	for p.pos < len(p.in) && strings.IndexByte(" \t\n\r", p.in[p.pos]) != -1 {
+		p.pos++
+	}
+}
+
+// Parse the segments following an identifier.
+func (p *jsonPathParser) parseSegments() ([]Matcher, error) {
+	mp := make([]Matcher, 0)
+	for {
+		// Whitespace is allowed between segments.
+		start := p.pos
+		p.skipSpaces()
+
+		var (
+			m   Matcher
+			err error
+		)
+		switch {
+		case p.consume('.', '.'):
+			if p.peek() == '[' {
+				m, err = p.parseBracket()
+			} else {
+				m, err = p.parseShorthand()
+			}
+			m = MatchDescendant{Matcher: m}
+		case p.consume('.'):
+			m, err = p.parseShorthand()
+		case p.peek() == '[':
+			m, err = p.parseBracket()
+		default:
+			p.pos = start
+			return mp, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		mp = append(mp, m)
+	}
+}
+
+// Parse a wildcard or a member name after a dot.
+func (p *jsonPathParser) parseShorthand() (Matcher, error) {
+	if p.consume('*') {
+		return MatchPattern{Pattern: "*"}, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.in) {
+		c := p.in[p.pos]
+		if c != '_' && c < utf8.RuneSelf && !isASCIILetter(c) && (p.pos == start || !isASCIIDigit(c)) {
+			break
+		}
+		p.pos++
+	}
+	if p.pos == start {
+		return nil, ErrInvalidPath
+	}
+	return MatchExact{Value: p.in[start:p.pos]}, nil
+}
+
+// Parse a bracketed list of selectors.
+func (p *jsonPathParser) parseBracket() (Matcher, error) {
+	if !p.consume('[') {
+		return nil, ErrInvalidPath
+	}
+
+	var matchers []Matcher
+	for {
+		p.skipSpaces()
+		m, err := p.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+
+		p.skipSpaces()
+		if p.consume(']') {
+			break
+		}
+		if !p.consume(',') {
+			return nil, ErrInvalidPath
+		}
+	}
+
+	if len(matchers) == 1 {
+		return matchers[0], nil
+	}
+	return MatchUnion{Matchers: matchers}, nil
+}
+
+// Parse a single selector inside brackets.
+func (p *jsonPathParser) parseSelector() (Matcher, error) {
+	switch c := p.peek(); {
+	case c == '\'' || c == '"':
+		name, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return MatchExact{Value: name}, nil
+	case c == '*':
+		p.pos++
+		return MatchPattern{Pattern: "*"}, nil
+	case c == '?':
+		p.pos++
+		p.skipSpaces()
+		cond, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return MatchFilter{Condition: cond}, nil
+	}
+	return p.parseIndexOrSlice()
+}
+
+// Parse an index or a slice of the form start:end:step.
+func (p *jsonPathParser) parseIndexOrSlice() (Matcher, error) {
+	var bounds [3]*int
+	n := 0
+	for {
+		p.skipSpaces()
+		if c := p.peek(); c == '-' || isASCIIDigit(c) {
+			i, err := p.parseInt()
+			if err != nil {
+				return nil, err
+			}
+			bounds[n] = &i
+		}
+		p.skipSpaces()
+
+		if n == 2 || !p.consume(':') {
+			break
+		}
+		n++
+	}
+
+	if n == 0 {
+		if bounds[0] == nil {
+			return nil, ErrInvalidPath
+		}
+		return MatchIndex{Index: *bounds[0]}, nil
+	}
+
+	m := MatchSlice{Start: bounds[0], End: bounds[1]}
+	if bounds[2] != nil {
+		// A step of 0 selects nothing.
+		if *bounds[2] == 0 {
+			return MatchUnion{}, nil
+		}
+		m.Step = *bounds[2]
+	}
+	return m, nil
+}
+
+func (p *jsonPathParser) parseInt() (int, error) {
+	start := p.pos
+	p.consume('-')
+	for isASCIIDigit(p.peek()) {
+		p.pos++
+	}
+	i, err := strconv.Atoi(p.in[start:p.pos])
+	if err != nil {
+		return 0, ErrInvalidPath
+	}
+	return i, nil
+}
+
+// Parse a quoted string literal.
+func (p *jsonPathParser) parseString() (string, error) {
+	quote := p.peek()
+	p.pos++
+
+	var sb strings.Builder
+	for p.pos < len(p.in) {
+		c := p.in[p.pos]
+		p.pos++
+		switch c {
+		case quote:
+			return sb.String(), nil
+		case '\\':
+			r, err := p.parseEscape(quote)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", ErrInvalidPath
+}
+
+// Parse the escape sequence after a backslash.
+func (p *jsonPathParser) parseEscape(quote byte) (rune, error) {
+	c := p.peek()
+	p.pos++
+	switch c {
+	case quote, '\\', '/':
+		return rune(c), nil
+	case 'b':
+		return '\b', nil
+	case 'f':
+		return '\f', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case 'u':
+		r, err := p.parseHex()
+		if err != nil {
+			return 0, err
+		}
+		if utf16.IsSurrogate(r) && p.consume('\\', 'u') {
+			r2, err := p.parseHex()
+			if err != nil {
+				return 0, err
+			}
+			r = utf16.DecodeRune(r, r2)
+		}
+		return r, nil
+	}
+	return 0, ErrInvalidPath
+}
+
+func (p *jsonPathParser) parseHex() (rune, error) {
+	if p.pos+4 > len(p.in) {
+		return 0, ErrInvalidPath
+	}
+	u, err := strconv.ParseUint(p.in[p.pos:p.pos+4], 16, 16)
+	if err != nil {
+		return 0, ErrInvalidPath
+	}
+	p.pos += 4
+	return rune(u), nil
+}
+
+// The condition of a filter selector.
+type filterCond = func(rv reflect.Value, t *Traveller) bool
+
+// The value of a filter operand.
+type filterValue struct {
+	// The value, invalid for null.
+	rv reflect.Value
+
+	// Whether the operand results in a value, false when a query has no result.
+	exists bool
+}
+
+// An operand of a comparison.
+type filterOperand = func(rv reflect.Value, t *Traveller) filterValue
+
+// Parse logical or expressions.
+func (p *jsonPathParser) parseOr() (filterCond, error) {
+	cond, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.skipSpaces(); p.consume('|', '|'); p.skipSpaces() {
+		left := cond
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		cond = func(rv reflect.Value, t *Traveller) bool {
+			return left(rv, t) || right(rv, t)
+		}
+	}
+	return cond, nil
+}
+
+// Parse logical and expressions.
+func (p *jsonPathParser) parseAnd() (filterCond, error) {
+	cond, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.skipSpaces(); p.consume('&', '&'); p.skipSpaces() {
+		left := cond
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		cond = func(rv reflect.Value, t *Traveller) bool {
+			return left(rv, t) && right(rv, t)
+		}
+	}
+	return cond, nil
+}
+
+// Parse negations, parenthesized expressions, existence tests, and comparisons.
+func (p *jsonPathParser) parseUnary() (filterCond, error) {
+	p.skipSpaces()
+	if p.consume('!') {
+		cond, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(rv reflect.Value, t *Traveller) bool {
+			return !cond(rv, t)
+		}, nil
+	}
+	if p.consume('(') {
+		cond, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if !p.consume(')') {
+			return nil, ErrInvalidPath
+		}
+		return cond, nil
+	}
+
+	left, leftMp, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+	op := p.parseComparisonOp()
+	if op == "" {
+		// Without a comparison, the operand must be a query to test its existence.
+		if leftMp == nil {
+			return nil, ErrInvalidPath
+		}
+		return existenceCond(leftMp), nil
+	}
+
+	p.skipSpaces()
+	right, rightMp, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	// Only queries resulting in at most one value can be compared.
+	if !isSingularQuery(leftMp) || !isSingularQuery(rightMp) {
+		return nil, ErrInvalidPath
+	}
+	return comparisonCond(op, left, right), nil
+}
+
+func (p *jsonPathParser) parseComparisonOp() string {
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if strings.HasPrefix(p.in[p.pos:], op) {
+			p.pos += len(op)
+			return op
+		}
+	}
+	return ""
+}
+
+// Parse a literal or a relative query.
+// The path of the query is returned along with the operand, nil for literals.
+func (p *jsonPathParser) parseOperand() (filterOperand, []Matcher, error) {
+	switch c := p.peek(); {
+	case c == '@':
+		p.pos++
+		mp, err := p.parseSegments()
+		if err != nil {
+			return nil, nil, err
+		}
+		return queryOperand(mp), mp, nil
+	case c == '$':
+		// Absolute queries require the root value which is unknown to the matchers.
+		return nil, nil, ErrInvalidPath
+	case c == '\'' || c == '"':
+		str, err := p.parseString()
+		if err != nil {
+			return nil, nil, err
+		}
+		return literalOperand(reflect.ValueOf(str)), nil, nil
+	case c == '-' || isASCIIDigit(c):
+		start := p.pos
+		for p.pos < len(p.in) && strings.IndexByte("+-.eE0123456789", p.in[p.pos]) != -1 {
+			p.pos++
+		}
+		f, err := strconv.ParseFloat(p.in[start:p.pos], 64)
+		if err != nil {
+			return nil, nil, ErrInvalidPath
+		}
+		return literalOperand(reflect.ValueOf(f)), nil, nil
+	case p.consume('t', 'r', 'u', 'e'):
+		return literalOperand(reflect.ValueOf(true)), nil, nil
+	case p.consume('f', 'a', 'l', 's', 'e'):
+		return literalOperand(reflect.ValueOf(false)), nil, nil
+	case p.consume('n', 'u', 'l', 'l'):
+		return literalOperand(reflect.Value{}), nil, nil
+	}
+	return nil, nil, ErrInvalidPath
+}
+
+// The operand of a literal value.
+func literalOperand(valRv reflect.Value) filterOperand {
+	return func(reflect.Value, *Traveller) filterValue {
+		return filterValue{rv: valRv, exists: true}
+	}
+}
+
+// The operand of a relative query, resulting in its first value.
+func queryOperand(mp []Matcher) filterOperand {
+	return func(rv reflect.Value, t *Traveller) filterValue {
+		var val filterValue
+		t.Query(rv, mp, func(f Found) bool {
+			val = filterValue{rv: Unbox(f.RV()), exists: true}
+			return false // Stop searching on first match.
+		})
+		return val
+	}
+}
+
+// The condition of whether the relative query results in any value.
+func existenceCond(mp []Matcher) filterCond {
+	return func(rv reflect.Value, t *Traveller) bool {
+		exists := false
+		t.Query(rv, mp, func(Found) bool {
+			exists = true
+			return false // Stop searching on first match.
+		})
+		return exists
+	}
+}
+
+// Whether the query results in at most one value.
+// Literals are represented by a nil path and are singular.
+func isSingularQuery(mp []Matcher) bool {
+	for _, m := range mp {
+		switch m.(type) {
+		case MatchExact, MatchIndex:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// The condition of comparing two operands.
+func comparisonCond(op string, left, right filterOperand) filterCond {
+	return func(rv reflect.Value, t *Traveller) bool {
+		a, b := left(rv, t), right(rv, t)
+		switch op {
+		case "==":
+			return filterEqual(a, b)
+		case "!=":
+			return !filterEqual(a, b)
+		case "<":
+			return filterLess(a, b)
+		case "<=":
+			return filterLess(a, b) || filterEqual(a, b)
+		case ">":
+			return filterLess(b, a)
+		case ">=":
+			return filterLess(b, a) || filterEqual(a, b)
+		}
+		return false
+	}
+}
+
+// Whether both filter values are equal.
+// Numbers of any kind are compared by their value.
+func filterEqual(a, b filterValue) bool {
+	if !a.exists || !b.exists {
+		return a.exists == b.exists
+	}
+	if !a.rv.IsValid() || !b.rv.IsValid() {
+		return a.rv.IsValid() == b.rv.IsValid()
+	}
+	if af, ok := numberAsFloat(a.rv); ok {
+		bf, ok := numberAsFloat(b.rv)
+		return ok && af == bf
+	}
+	return a.rv.CanInterface() && b.rv.CanInterface() && reflect.DeepEqual(a.rv.Interface(), b.rv.Interface())
+}
+
+// Whether the first filter value is ordered before the second.
+// Only numbers and strings are ordered.
+func filterLess(a, b filterValue) bool {
+	if !a.exists || !b.exists || !a.rv.IsValid() || !b.rv.IsValid() {
+		return false
+	}
+	if af, ok := numberAsFloat(a.rv); ok {
+		bf, ok := numberAsFloat(b.rv)
+		return ok && af < bf
+	}
+	if a.rv.Kind() == reflect.String && b.rv.Kind() == reflect.String {
+		return a.rv.String() < b.rv.String()
+	}
+	return false
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
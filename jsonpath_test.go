@@ -0,0 +1,122 @@
+package traveller_test
+
+import (
+	"fmt"
+
+	"github.com/ezraisw/traveller"
+)
+
+func (s PathTestSuite) TestCallMustJSONPathPanic() {
+	s.Panics(func() {
+		traveller.MustJSONPath("store.book")
+	})
+}
+
+func (s PathTestSuite) TestCallJSONPath() {
+	one, two, minusOne := 1, 2, -1
+	cases := []pathSubTestCase{
+		{
+			in:       "$",
+			expected: []traveller.Matcher{},
+		},
+		{
+			in:       "$.store.book[*].author",
+			expected: []traveller.Matcher{traveller.MatchExact{Value: "store"}, traveller.MatchExact{Value: "book"}, traveller.MatchPattern{Pattern: "*"}, traveller.MatchExact{Value: "author"}},
+		},
+		{
+			in:       "$['store'][\"a.b\\u0021\"]",
+			expected: []traveller.Matcher{traveller.MatchExact{Value: "store"}, traveller.MatchExact{Value: "a.b!"}},
+		},
+		{
+			in:       "$..author",
+			expected: []traveller.Matcher{traveller.MatchDescendant{Matcher: traveller.MatchExact{Value: "author"}}},
+		},
+		{
+			in:       "$..[0]",
+			expected: []traveller.Matcher{traveller.MatchDescendant{Matcher: traveller.MatchIndex{Index: 0}}},
+		},
+		{
+			in: "$[-1][1:2][::-1][:-1:2][ 0 , 'a' ]",
+			expected: []traveller.Matcher{
+				traveller.MatchIndex{Index: -1},
+				traveller.MatchSlice{Start: &one, End: &two},
+				traveller.MatchSlice{Step: -1},
+				traveller.MatchSlice{End: &minusOne, Step: 2},
+				traveller.MatchUnion{Matchers: []traveller.Matcher{traveller.MatchIndex{Index: 0}, traveller.MatchExact{Value: "a"}}},
+			},
+		},
+		{
+			in:  "store",
+			err: traveller.ErrInvalidPath,
+		},
+		{
+			in:  "$.",
+			err: traveller.ErrInvalidPath,
+		},
+		{
+			in:  "$[1",
+			err: traveller.ErrInvalidPath,
+		},
+		{
+			in:  "$['a]",
+			err: traveller.ErrInvalidPath,
+		},
+		{
+			in:  "$[?@.a == $.b]",
+			err: traveller.ErrInvalidPath,
+		},
+		{
+			in:  "$[?@.* == 1]",
+			err: traveller.ErrInvalidPath,
+		},
+		{
+			in:  "$[?1]",
+			err: traveller.ErrInvalidPath,
+		},
+	}
+
+	for i, c := range cases {
+		s.Run(fmt.Sprintf("Case #%d", i+1), func() {
+			mp, err := traveller.JSONPath(c.in)
+			if c.err == nil {
+				s.NoError(err)
+				s.Equal(c.expected, mp)
+			} else {
+				s.ErrorIs(err, c.err)
+			}
+		})
+	}
+}
+
+func (s PathTestSuite) TestCallGetAllJSONPath() {
+	in := makeBulb()
+	json := traveller.WithTagName("json")
+
+	s.Equal([]int{744, 684, 151, 243, 507},
+		traveller.GetAll[int](in, traveller.MustJSONPath("$.Federation.Hate.Critic[*]"), json))
+	s.Equal([]int{696969, 99214, 999999},
+		traveller.GetAll[int](in, traveller.MustJSONPath("$..peace"), json))
+	s.Equal([]int{507},
+		traveller.GetAll[int](in, traveller.MustJSONPath("$.Federation.Hate.Critic[-1]"), json))
+	s.Equal([]int{744, 684},
+		traveller.GetAll[int](in, traveller.MustJSONPath("$.Federation.Hate.Critic[:2]"), json))
+	s.Equal([]int{507, 151, 744},
+		traveller.GetAll[int](in, traveller.MustJSONPath("$.Federation.Hate.Critic[::-2]"), json))
+	s.Equal([]any{"ZPGANa8QAKvR7AFzXwCn", 69.999},
+		traveller.GetAll[any](in, traveller.MustJSONPath("$.Federation.jet['party','retirement']"), json))
+	s.Equal([]int{151, 243},
+		traveller.GetAll[int](in, traveller.MustJSONPath("$.Federation.Hate.Critic[?@ < 500]"), json))
+	s.Equal([]string{"St1ABpJxt6l5ktcDnXs6"},
+		traveller.GetAll[string](in, traveller.MustJSONPath("$.Federation.Hate.Slide.Swipe[?@.peace > 1000].plain"), json))
+	s.Equal([]string{"gJ5jRBNRbdSK9buzDa0z"},
+		traveller.GetAll[string](in, traveller.MustJSONPath(`$..[?(@.peace > 100000 && !(@.peace > 999998)) || @.plain == 'none'].plain`), json))
+	s.Equal([]int{420},
+		traveller.GetAll[int](in, traveller.MustJSONPath("$.Federation.jet[?@.Outside >= 34].Pumpkin"), json))
+	s.ElementsMatch([]int{34, 420},
+		traveller.GetAll[int](in, traveller.MustJSONPath("$.Federation.jet..*"), json))
+	s.Len(traveller.GetAll[any](in, traveller.MustJSONPath("$.Federation.jet..*"), json), 5)
+
+	in.Cup["Nothing"] = nil
+	s.Equal([]any{nil}, traveller.GetAll[any](in, traveller.MustJSONPath("$..[?@.Nothing == null].Nothing"), json))
+	s.Len(traveller.GetAll[any](in, traveller.MustJSONPath("$[?@.Nothing]"), json), 1)
+}
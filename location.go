@@ -0,0 +1,197 @@
+package traveller
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// The location of a value, represented by the keys to obtain the value from the root.
+//
+// The type of each key depends on the parent's kind:
+// reflect.Map is the map key itself, reflect.Struct is string, reflect.Array is int.
+type Location []any
+
+// Convert the location into a path of exact matchers.
+func (l Location) Matchers() []Matcher {
+	mp := make([]Matcher, 0, len(l))
+	for _, key := range l {
+		mp = append(mp, MatchExact{Value: key})
+	}
+	return mp
+}
+
+// Render the location as a string path that can be parsed by Path.
+//
+// Keys that are not strings are formatted using AssumeAsString. Dots, asterisks, and
+// backslashes in the keys are escaped, so that Path matches the keys exactly.
+func (l Location) String() string {
+	keys := make([]string, 0, len(l))
+	for _, key := range l {
+		keys = append(keys, escapePathKey(formatKey(key)))
+	}
+	return strings.Join(keys, ".")
+}
+
+// Render the location as a JSON Pointer (RFC 6901) that can be parsed by Pointer.
+//
+// Keys that are not strings are formatted using AssumeAsString.
+func (l Location) Pointer() string {
+	var sb strings.Builder
+	for _, key := range l {
+		sb.WriteByte('/')
+		sb.WriteString(pointerEscaper.Replace(formatKey(key)))
+	}
+	return sb.String()
+}
+
+// The trail of keys from the root to a traversed value.
+type trail struct {
+	parent *trail
+	key    any
+	depth  int
+
+	// The excluded locations inside the value.
+	excluded *locationTrie
+}
+
+// Create the trail of a child obtained using the given key.
+func (tr *trail) child(key any) *trail {
+	key = locationKey(key)
+	return &trail{
+		parent:   tr,
+		key:      key,
+		depth:    tr.depth + 1,
+		excluded: tr.excluded.child(key),
+	}
+}
+
+// Whether the value of the trail is excluded from traversal.
+func (tr *trail) isExcluded() bool {
+	return tr.excluded.isEnd()
+}
+
+// Obtain the location of the trail.
+func (tr *trail) location() Location {
+	l := make(Location, tr.depth)
+	tr.fill(l)
+	return l
+}
+
+// Fill the beginning of the location with the keys of the trail.
+func (tr *trail) fill(l Location) {
+	for cur := tr; cur.parent != nil; cur = cur.parent {
+		l[cur.depth-1] = cur.key
+	}
+}
+
+// A key on the stack of the trail of the traveller.
+type trailEntry struct {
+	parentRv reflect.Value
+	key      any
+
+	// The excluded locations inside the value.
+	excluded *locationTrie
+
+	// The trail of the entry once it is created.
+	trail *trail
+}
+
+// A set of locations stored as a tree of keys.
+type locationTrie struct {
+	end      bool
+	children map[any]*locationTrie
+}
+
+// Add a location into the set.
+func (lt *locationTrie) add(l Location) {
+	lt.node(l).end = true
+}
+
+// Get the node of the location, creating the nodes leading to it.
+func (lt *locationTrie) node(l Location) *locationTrie {
+	cur := lt
+	for _, key := range l {
+		next, ok := cur.children[key]
+		if !ok {
+			if cur.children == nil {
+				cur.children = make(map[any]*locationTrie)
+			}
+			next = &locationTrie{}
+			cur.children[key] = next
+		}
+		cur = next
+	}
+	return cur
+}
+
+// Make the location share the node of the target, so that it holds the same locations.
+// The locations already inside the location are moved into the target.
+func (lt *locationTrie) link(l, target Location) {
+	if len(l) == 0 {
+		return
+	}
+	parent, key := lt.node(l[:len(l)-1]), l[len(l)-1]
+	src, dst := parent.children[key], lt.node(target)
+	if src.isEnd() || src == dst {
+		return
+	}
+	if src != nil {
+		dst.merge(src, make(map[*locationTrie]bool))
+	}
+	if parent.children == nil {
+		parent.children = make(map[any]*locationTrie)
+	}
+	parent.children[key] = dst
+}
+
+// Add the locations of the other set into the set.
+func (lt *locationTrie) merge(other *locationTrie, merged map[*locationTrie]bool) {
+	if lt == other || merged[other] {
+		return
+	}
+	merged[other] = true
+	lt.end = lt.end || other.end
+	for key, child := range other.children {
+		if cur, ok := lt.children[key]; ok {
+			cur.merge(child, merged)
+			continue
+		}
+		if lt.children == nil {
+			lt.children = make(map[any]*locationTrie)
+		}
+		lt.children[key] = child
+	}
+}
+
+// Whether the location leading to this node is in the set.
+func (lt *locationTrie) isEnd() bool {
+	return lt != nil && lt.end
+}
+
+// Get the set of locations inside the given key.
+func (lt *locationTrie) child(key any) *locationTrie {
+	if lt == nil {
+		return nil
+	}
+	return lt.children[key]
+}
+
+// Format a key of a location as a string.
+func formatKey(key any) string {
+	if keyRv := reflect.ValueOf(key); keyRv.IsValid() {
+		if str, ok := AssumeAsString(keyRv); ok {
+			return str
+		}
+	}
+	return fmt.Sprint(key)
+}
+
+// Obtain the key used in a location from the key given by matchers.
+// Map keys are given as reflect.Value, which are not comparable by their content.
+func locationKey(key any) any {
+	if keyRv, ok := key.(reflect.Value); ok {
+		return keyRv.Interface()
+	}
+	return key
+}
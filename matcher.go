@@ -25,6 +25,8 @@ type MatchExact struct {
 	// To match a field name of a struct, use a string.
 	// To match an index of an array/slice, use an int.
 	// To match a map key, use the correct key type of that map.
+	//
+	// With WithParseKeys, strings are also parsed into indexes and map keys.
 	Value any
 }
 
@@ -32,6 +34,13 @@ type MatchExact struct {
 var _ Matcher = (*MatchExact)(nil)
 
 func (m MatchExact) Match(rv reflect.Value, s MatcherSegment) bool {
+	if tr, trRv, ok := asTraversable(rv); ok {
+		if childRv, ok := traversableChild(tr, m.Value); ok {
+			return s.Next(childRv, trRv, traversableKey(tr, m.Value))
+		}
+		return true
+	}
+
 	switch rv := Unbox(rv); rv.Kind() {
 	case reflect.Struct:
 		return m.matchStruct(rv, s)
@@ -51,14 +60,16 @@ func (m MatchExact) matchStruct(rv reflect.Value, s MatcherSegment) bool {
 		rt := rv.Type()
 		for i := 0; i < rv.NumField(); i++ {
 			field := rt.Field(i)
-			if !field.IsExported() {
+			fieldName, ok := s.Traveller().FieldName(field)
+			if !ok {
 				continue
 			}
-			if field.Name == name && !s.Next(rv.Field(i), rv, name) {
+			fieldRv := s.Traveller().Field(rv, i)
+			if fieldName == name && !s.Next(fieldRv, rv, field.Name) {
 				return false
 			}
 			// Check embedded values.
-			if !s.Traveller().NoFlatEmbeds() && field.Anonymous && !s.Stay(rv.Field(i), rv, name) {
+			if !s.Traveller().NoFlatEmbeds() && field.Anonymous && !s.Stay(fieldRv, rv, field.Name) {
 				return false
 			}
 		}
@@ -70,7 +81,10 @@ func (m MatchExact) matchMap(rv reflect.Value, s MatcherSegment) bool {
 	if s.Traveller().IgnoreMap() {
 		return true
 	}
-	keyRv := reflect.ValueOf(m.Value)
+	keyRv, ok := convertKey(m.Value, rv.Type().Key(), s.Traveller().ParseKeys())
+	if !ok {
+		return true
+	}
 	if valueRv := rv.MapIndex(keyRv); valueRv.IsValid() {
 		if !s.Next(valueRv, rv, keyRv) {
 			return false
@@ -83,7 +97,7 @@ func (m MatchExact) matchArray(rv reflect.Value, s MatcherSegment) bool {
 	if s.Traveller().IgnoreArray() {
 		return true
 	}
-	if i, ok := m.Value.(int); ok && i >= 0 && i < rv.Len() {
+	if i, ok := indexKey(m.Value, s.Traveller().ParseKeys()); ok && i < rv.Len() {
 		if !s.Next(rv.Index(i), rv, i) {
 			return false
 		}
@@ -109,6 +123,10 @@ type MatchPattern struct {
 var _ Matcher = (*MatchPattern)(nil)
 
 func (m MatchPattern) Match(rv reflect.Value, s MatcherSegment) bool {
+	if tr, trRv, ok := asTraversable(rv); ok {
+		return m.matchTraversable(tr, trRv, s)
+	}
+
 	switch rv := Unbox(rv); rv.Kind() {
 	case reflect.Struct:
 		return m.matchStruct(rv, s)
@@ -126,13 +144,14 @@ func (m MatchPattern) matchStruct(rv reflect.Value, s MatcherSegment) bool {
 	}
 	for i := 0; i < rv.NumField(); i++ {
 		field := rv.Type().Field(i)
-		if !field.IsExported() {
+		fieldName, ok := s.Traveller().FieldName(field)
+		if !ok {
 			continue
 		}
-		if !wild.Match(m.Pattern, field.Name, m.CaseInsensitive) {
+		if !wild.Match(m.Pattern, fieldName, m.CaseInsensitive) {
 			continue
 		}
-		fieldRv := rv.Field(i)
+		fieldRv := s.Traveller().Field(rv, i)
 		if !s.Next(fieldRv, rv, field.Name) {
 			return false
 		}
@@ -148,31 +167,52 @@ func (m MatchPattern) matchMap(rv reflect.Value, s MatcherSegment) bool {
 	if s.Traveller().IgnoreMap() {
 		return true
 	}
-	for it := rv.MapRange(); it.Next(); {
-		keyRv := it.Key()
-		// Force key as string.
-		var (
-			keyStr string
-			ok     bool
-		)
-		if m.OnlyStringKey {
-			if keyRv.Kind() == reflect.String {
-				keyStr, ok = keyRv.String(), true
+	if s.Traveller().SortedMaps() {
+		for _, keyRv := range s.Traveller().MapKeys(rv) {
+			if m.matchKey(keyRv) && !s.Next(rv.MapIndex(keyRv), rv, keyRv) {
+				return false
 			}
-		} else {
-			keyStr, ok = AssumeAsString(keyRv)
 		}
-
-		if !ok || !wild.Match(m.Pattern, keyStr, m.CaseInsensitive) {
-			continue
-		}
-		if !s.Next(it.Value(), rv, keyRv) {
+		return true
+	}
+	for it := rv.MapRange(); it.Next(); {
+		if keyRv := it.Key(); m.matchKey(keyRv) && !s.Next(it.Value(), rv, keyRv) {
 			return false
 		}
 	}
 	return true
 }
 
+// Whether the map key matches the pattern.
+func (m MatchPattern) matchKey(keyRv reflect.Value) bool {
+	// Force key as string.
+	var (
+		keyStr string
+		ok     bool
+	)
+	if m.OnlyStringKey {
+		if keyRv.Kind() == reflect.String {
+			keyStr, ok = keyRv.String(), true
+		}
+	} else {
+		keyStr, ok = AssumeAsString(keyRv)
+	}
+	return ok && wild.Match(m.Pattern, keyStr, m.CaseInsensitive)
+}
+
+func (m MatchPattern) matchTraversable(tr Traversable, rv reflect.Value, s MatcherSegment) bool {
+	return forEachTraversable(tr, func(childRv reflect.Value, key any) bool {
+		keyStr, ok := key.(string)
+		if !ok && !m.OnlyStringKey && key != nil {
+			keyStr, ok = AssumeAsString(reflect.ValueOf(key))
+		}
+		if !ok || !wild.Match(m.Pattern, keyStr, m.CaseInsensitive) {
+			return true
+		}
+		return s.Next(childRv, rv, key)
+	})
+}
+
 func (m MatchPattern) matchArray(rv reflect.Value, s MatcherSegment) bool {
 	if s.Traveller().IgnoreArray() {
 		return true
@@ -182,8 +222,8 @@ func (m MatchPattern) matchArray(rv reflect.Value, s MatcherSegment) bool {
 		if m.OnlyStringKey {
 			continue
 		}
-		// Force index as string.
-		if !wild.Match(m.Pattern, strconv.Itoa(i), m.CaseInsensitive) {
+		// Force index as string. Every index matches the pattern of "*".
+		if m.Pattern != "*" && !wild.Match(m.Pattern, strconv.Itoa(i), m.CaseInsensitive) {
 			continue
 		}
 		if !s.Next(rv.Index(i), rv, i) {
@@ -204,6 +244,10 @@ type MatchMulti struct {
 var _ Matcher = (*MatchMulti)(nil)
 
 func (m MatchMulti) Match(rv reflect.Value, s MatcherSegment) bool {
+	if tr, trRv, ok := asTraversable(rv); ok {
+		return m.matchTraversable(tr, trRv, s)
+	}
+
 	switch rv := Unbox(rv); rv.Kind() {
 	case reflect.Struct:
 		return m.matchStruct(rv, s)
@@ -216,7 +260,7 @@ func (m MatchMulti) Match(rv reflect.Value, s MatcherSegment) bool {
 }
 
 // Needs to be separated so the matcher gets the field value twice.
-// Do not use the same reflect.Value.
+// Do not use the same reflect.Value for copies that a modifying traversal may have replaced.
 
 func (m MatchMulti) op1(childRv reflect.Value, rv reflect.Value, key any, s MatcherSegment) bool {
 	if m.StayFirst {
@@ -237,8 +281,12 @@ func (m MatchMulti) matchStruct(rv reflect.Value, s MatcherSegment) bool {
 		return true
 	}
 	for i := 0; i < rv.NumField(); i++ {
-		if field := rv.Type().Field(i); field.IsExported() &&
-			(!m.op1(rv.Field(i), rv, field.Name, s) || !m.op2(rv.Field(i), rv, field.Name, s)) {
+		field := rv.Type().Field(i)
+		if _, ok := s.Traveller().FieldName(field); !ok {
+			continue
+		}
+		key := any(field.Name)
+		if !m.op1(s.Traveller().Field(rv, i), rv, key, s) || !m.op2(s.Traveller().Field(rv, i), rv, key, s) {
 			return false
 		}
 	}
@@ -249,8 +297,28 @@ func (m MatchMulti) matchMap(rv reflect.Value, s MatcherSegment) bool {
 	if s.Traveller().IgnoreMap() {
 		return true
 	}
-	for _, keyRv := range rv.MapKeys() {
-		if !m.op1(rv.MapIndex(keyRv), rv, keyRv, s) || !m.op2(rv.MapIndex(keyRv), rv, keyRv, s) {
+	return s.Traveller().rangeMap(rv, func(keyRv, childRv reflect.Value) bool {
+		key := any(keyRv)
+		if !m.op1(childRv, rv, key, s) {
+			return false
+		}
+		if s.Traveller().cb.OnTraversal != nil {
+			childRv = rv.MapIndex(keyRv)
+		}
+		return m.op2(childRv, rv, key, s)
+	})
+}
+
+func (m MatchMulti) matchTraversable(tr Traversable, rv reflect.Value, s MatcherSegment) bool {
+	for _, key := range tr.TravellerKeys() {
+		childRv, ok := traversableChild(tr, key)
+		if !ok {
+			continue
+		}
+		if !m.op1(childRv, rv, key, s) {
+			return false
+		}
+		if childRv, ok = traversableChild(tr, key); ok && !m.op2(childRv, rv, key, s) {
 			return false
 		}
 	}
@@ -262,9 +330,242 @@ func (m MatchMulti) matchArray(rv reflect.Value, s MatcherSegment) bool {
 		return true
 	}
 	for i := 0; i < rv.Len(); i++ {
-		if !m.op1(rv.Index(i), rv, i, s) || !m.op2(rv.Index(i), rv, i, s) {
+		key := any(i)
+		if !m.op1(rv.Index(i), rv, key, s) || !m.op2(rv.Index(i), rv, key, s) {
 			return false
 		}
 	}
 	return true
 }
+
+// Match an index of an array/slice, with negative indexes counting from the end.
+// Traversable containers such as *list.List are matched by the int key of the index.
+type MatchIndex struct {
+	// The index to match with. The index of -1 is the last element.
+	Index int
+}
+
+// Compile-time implementation check.
+var _ Matcher = (*MatchIndex)(nil)
+
+func (m MatchIndex) Match(rv reflect.Value, s MatcherSegment) bool {
+	if tr, trRv, ok := asTraversable(rv); ok {
+		i := m.Index
+		if i < 0 {
+			i += len(tr.TravellerKeys())
+		}
+		if i < 0 {
+			return true
+		}
+		if childRv, ok := traversableChild(tr, i); ok {
+			return s.Next(childRv, trRv, i)
+		}
+		return true
+	}
+
+	rv = Unbox(rv)
+	if (rv.Kind() != reflect.Array && rv.Kind() != reflect.Slice) || s.Traveller().IgnoreArray() {
+		return true
+	}
+	i := m.Index
+	if i < 0 {
+		i += rv.Len()
+	}
+	if i < 0 || i >= rv.Len() {
+		return true
+	}
+	return s.Next(rv.Index(i), rv, i)
+}
+
+// Match a range of indexes of an array/slice, similar to Python slices.
+// Traversable containers such as *list.List are matched by the int keys of the indexes.
+type MatchSlice struct {
+	// The first index, inclusive. Negative indexes count from the end.
+	// Defaults to the first element, or the last element for a negative step.
+	Start *int
+
+	// The last index, exclusive. Negative indexes count from the end.
+	// Defaults to past the last element, or before the first element for a negative step.
+	End *int
+
+	// The distance between matched indexes. A negative step matches in reverse.
+	// The step of 0 is treated as 1.
+	Step int
+}
+
+// Compile-time implementation check.
+var _ Matcher = (*MatchSlice)(nil)
+
+func (m MatchSlice) Match(rv reflect.Value, s MatcherSegment) bool {
+	if tr, trRv, ok := asTraversable(rv); ok {
+		return m.forEachIndex(len(tr.TravellerKeys()), func(i int) bool {
+			if childRv, ok := traversableChild(tr, i); ok {
+				return s.Next(childRv, trRv, i)
+			}
+			return true
+		})
+	}
+
+	rv = Unbox(rv)
+	if (rv.Kind() != reflect.Array && rv.Kind() != reflect.Slice) || s.Traveller().IgnoreArray() {
+		return true
+	}
+	return m.forEachIndex(rv.Len(), func(i int) bool {
+		return s.Next(rv.Index(i), rv, i)
+	})
+}
+
+// Call fn on each matched index of a sequence with the given length, in order of the step.
+func (m MatchSlice) forEachIndex(n int, fn func(i int) bool) bool {
+	step := m.Step
+	if step == 0 {
+		step = 1
+	}
+	bound := func(i *int, def int) int {
+		if i == nil {
+			return def
+		}
+		if *i < 0 {
+			return *i + n
+		}
+		return *i
+	}
+	clamp := func(i, lower, upper int) int {
+		if i < lower {
+			return lower
+		}
+		if i > upper {
+			return upper
+		}
+		return i
+	}
+
+	if step > 0 {
+		end := clamp(bound(m.End, n), 0, n)
+		for i := clamp(bound(m.Start, 0), 0, n); i < end; i += step {
+			if !fn(i) {
+				return false
+			}
+		}
+	} else {
+		end := clamp(bound(m.End, -1), -1, n-1)
+		for i := clamp(bound(m.Start, n-1), -1, n-1); i > end; i += step {
+			if !fn(i) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Match using all of the given matchers on the same value.
+type MatchUnion struct {
+	// The matchers to use, in order.
+	Matchers []Matcher
+}
+
+// Compile-time implementation check.
+var _ Matcher = (*MatchUnion)(nil)
+
+func (m MatchUnion) Match(rv reflect.Value, s MatcherSegment) bool {
+	for _, matcher := range m.Matchers {
+		if !matcher.Match(rv, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// Match the children that satisfy a condition.
+//
+// The children are the struct fields, map values, and array/slice elements.
+// Fields of embedded structs are treated as children of the parent unless NoFlatEmbeds is set.
+type MatchFilter struct {
+	// The condition of a child value.
+	// The traveller is given to allow traversal using the same options.
+	Condition func(rv reflect.Value, t *Traveller) bool
+}
+
+// Compile-time implementation check.
+var _ Matcher = (*MatchFilter)(nil)
+
+func (m MatchFilter) Match(rv reflect.Value, s MatcherSegment) bool {
+	return forEachChild(rv, s.Traveller(), func(childRv, parentRv reflect.Value, key any) bool {
+		if !m.Condition(childRv, s.Traveller()) {
+			return true
+		}
+		return s.Next(childRv, parentRv, key)
+	})
+}
+
+// Match using the given matcher on the value and all of its descendants.
+//
+// Unlike MatchMulti, the current value is included and each value is only visited once.
+// Fields of embedded structs are treated as children of the parent unless NoFlatEmbeds is set.
+type MatchDescendant struct {
+	// The matcher to use on each value.
+	Matcher Matcher
+}
+
+// Compile-time implementation check.
+var _ Matcher = (*MatchDescendant)(nil)
+
+func (m MatchDescendant) Match(rv reflect.Value, s MatcherSegment) bool {
+	if !m.Matcher.Match(rv, s) {
+		return false
+	}
+	return forEachChild(rv, s.Traveller(), func(childRv, parentRv reflect.Value, key any) bool {
+		return s.Stay(childRv, parentRv, key)
+	})
+}
+
+// Call fn on each child of the unboxed value.
+// Fields of embedded structs are flattened into their parent unless NoFlatEmbeds is set.
+func forEachChild(rv reflect.Value, t *Traveller, fn func(childRv, parentRv reflect.Value, key any) bool) bool {
+	if tr, trRv, ok := asTraversable(rv); ok {
+		return forEachTraversable(tr, func(childRv reflect.Value, key any) bool {
+			return fn(childRv, trRv, key)
+		})
+	}
+
+	switch rv := Unbox(rv); rv.Kind() {
+	case reflect.Struct:
+		if t.IgnoreStruct() {
+			return true
+		}
+		rt := rv.Type()
+		for i := 0; i < rv.NumField(); i++ {
+			field := rt.Field(i)
+			if _, ok := t.FieldName(field); !ok {
+				continue
+			}
+			fieldRv := t.Field(rv, i)
+			if !t.NoFlatEmbeds() && field.Anonymous && Unbox(fieldRv).Kind() == reflect.Struct {
+				if !forEachChild(fieldRv, t, fn) {
+					return false
+				}
+				continue
+			}
+			if !fn(fieldRv, rv, field.Name) {
+				return false
+			}
+		}
+	case reflect.Map:
+		if t.IgnoreMap() {
+			return true
+		}
+		return t.rangeMap(rv, func(keyRv, childRv reflect.Value) bool {
+			return fn(childRv, rv, keyRv)
+		})
+	case reflect.Array, reflect.Slice:
+		if t.IgnoreArray() {
+			return true
+		}
+		for i := 0; i < rv.Len(); i++ {
+			if !fn(rv.Index(i), rv, i) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package traveller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Apply a JSON Merge Patch (RFC 7386) to the given value.
+//
+// Objects of the patch are merged recursively into structs and maps, while every other value
+// replaces the target as a whole. A nil value deletes the map entry or resets the struct field.
+// Missing map entries and nil pointers are created as needed, and unknown struct fields
+// are ignored.
+//
+// Struct fields are named by their json tag by default, which can be changed through
+// WithTagName. Keys of the patch are parsed into map keys (WithParseKeys). Values are
+// converted into the type of their target as long as no information is lost. Unexported
+// fields and fields that are not in the patch are left untouched.
+//
+// The patch is applied on a deep copy of the value, which is only assigned back on success.
+//
+// `in` must be a pointer to a value or it will panic.
+func MergePatch(in any, patch map[string]any, options ...TravellerOption) error {
+	inRv := settableRoot(in)
+
+	options = append([]TravellerOption{WithTagName("json"), WithParseKeys(true)}, options...)
+	traveller := &Traveller{}
+	traveller.applyOptions(options)
+
+	m := merger{traveller: traveller, options: options}
+	workRv := reflect.New(inRv.Type()).Elem()
+	workRv.Set(deepCopy(inRv))
+
+	if err := m.mergeValue(workRv, patch, Location{}); err != nil {
+		return err
+	}
+
+	inRv.Set(workRv)
+	return nil
+}
+
+// Applier of merge patches on settable values.
+type merger struct {
+	traveller *Traveller
+	options   []TravellerOption
+}
+
+// Merge each key of the patch into the object contained in rv.
+func (m merger) merge(rv reflect.Value, patch map[string]any, location Location) error {
+	keys := make([]string, 0, len(patch))
+	for key := range patch {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		val := patch[key]
+		exact := MatchExact{Value: key}
+
+		if val == nil {
+			deleteAll(rv, []Matcher{exact}, m.options)
+			continue
+		}
+
+		var (
+			count = 0
+			err   error
+		)
+
+		cb := TravellerCallback{
+			OnTraversal: handleInaddrVals,
+			OnFound: func(f Found) bool {
+				if err = m.mergeValue(f.RV(), val, append(location[:len(location):len(location)], key)); err == nil {
+					count++
+				}
+				return false // Only merge into the first match.
+			},
+		}
+
+		StartTraversal(rv, []Matcher{matchUpsert{MatchExact: exact, count: &count}}, cb, m.options...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Merge the value of the patch into rv.
+func (m merger) mergeValue(rv reflect.Value, val any, location Location) error {
+	if patch, ok := val.(map[string]any); ok {
+		switch {
+		case rv.Kind() == reflect.Ptr && rv.IsNil() && isObjectKind(rv.Type().Elem().Kind()):
+			rv.Set(reflect.New(rv.Type().Elem()))
+		case rv.Kind() == reflect.Interface && !isObjectKind(Unbox(rv).Kind()):
+			// Values that are not objects are replaced by an object to merge into.
+			setAssignable(rv, map[string]any{})
+		}
+		if isObjectKind(Unbox(rv).Kind()) {
+			return m.merge(rv, patch, location)
+		}
+	}
+
+	valRv, ok := m.traveller.convertValue(val, rv.Type())
+	if !ok {
+		return fmt.Errorf("merge patch %s: %w", location, ErrUnassignable)
+	}
+	rv.Set(valRv)
+	return nil
+}
+
+// Whether the kind can be merged into as an object.
+func isObjectKind(kind reflect.Kind) bool {
+	return kind == reflect.Struct || kind == reflect.Map
+}
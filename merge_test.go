@@ -0,0 +1,80 @@
+package traveller_test
+
+import (
+	"encoding/json"
+
+	"github.com/ezraisw/traveller"
+)
+
+func (s GeneralTestSuite) TestCallMergePatch() {
+	var patch map[string]any
+	s.Require().NoError(json.Unmarshal([]byte(`{
+		"Sunshine": 122,
+		"Worth": ["only"],
+		"Cup": {"Favour": {"debug": true, "drop": null}},
+		"Federation": {
+			"jet": {"party": "edited", "Tiger": "exposed"},
+			"decline": {"Victory": "edited", "Instinct": null, "Added": "new"}
+		},
+		"unknown": 1
+	}`), &patch))
+
+	in := makeBulb()
+	s.Require().NoError(traveller.MergePatch(&in, patch))
+
+	expected := makeBulb()
+	expected.Sunshine = 122
+	expected.Worth = []string{"only"}
+	expected.Cup["Favour"] = map[string]any{"debug": true}
+	expected.Federation.Jet.Party = "edited"
+	expected.Federation.Decline = map[string]string{"Victory": "edited", "Added": "new"}
+	s.Equal(expected, in)
+
+	s.Require().NoError(traveller.MergePatch(&in, map[string]any{
+		"Cup":        map[string]any{"Favour": map[string]any{"debug": false}},
+		"Federation": map[string]any{"decline": nil},
+	}))
+	expected.Cup["Favour"] = map[string]any{"debug": false}
+	expected.Federation.Decline = nil
+	s.Equal(expected, in)
+
+	// Pointers are created for the values merged into them.
+	var c chain
+	s.Require().NoError(traveller.MergePatch(&c, map[string]any{"Next": map[string]any{"Name": "next", "Cache": map[string]any{"a": 1}}}))
+	s.Require().NoError(traveller.MergePatch(&c, map[string]any{"Next": map[string]any{"Cache": map[string]any{"b": 2}}}))
+	s.Equal(chain{Next: &chain{Name: "next", Cache: map[string]any{"a": 1, "b": 2}}}, c)
+}
+
+func (s GeneralTestSuite) TestCallMergePatchError() {
+	in := makeBulb()
+
+	err := traveller.MergePatch(&in, map[string]any{
+		"Band":       "changed",
+		"Federation": map[string]any{"jet": map[string]any{"retirement": "high"}},
+	})
+	s.ErrorIs(err, traveller.ErrUnassignable)
+	s.EqualError(err, "merge patch Federation.jet.retirement: value unassignable")
+
+	err = traveller.MergePatch(&in, map[string]any{"Federation": map[string]any{"clean": []any{-1.5}}})
+	s.ErrorIs(err, traveller.ErrUnassignable)
+
+	s.Equal(makeBulb(), in)
+}
+
+func (s GeneralTestSuite) TestCallMergePatchMap() {
+	in := map[string]any{
+		"a": "b",
+		"c": map[string]any{"d": "e", "f": "g"},
+	}
+	err := traveller.MergePatch(&in, map[string]any{
+		"a": "z",
+		"c": map[string]any{"f": nil},
+		"h": map[string]any{"i": nil, "j": "k"},
+	})
+	s.NoError(err)
+	s.Equal(map[string]any{
+		"a": "z",
+		"c": map[string]any{"d": "e"},
+		"h": map[string]any{"j": "k"},
+	}, in)
+}
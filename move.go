@@ -0,0 +1,157 @@
+package traveller
+
+import (
+	"reflect"
+
+	"github.com/gertd/wild"
+)
+
+// Move the first value matching `from` into `to`.
+//
+// The value is upserted into `to`, then the moved value is deleted from its location.
+// Other values matching `from` are left as is. Nothing is deleted if the value cannot
+// be assigned into `to`, and nothing is moved if `to` is the location of the value or
+// inside of it.
+// See Upsert and Delete for the behaviour of each step.
+//
+// `in` must be a pointer to a value or it will panic.
+func Move(in any, from, to []Matcher, options ...TravellerOption) bool {
+	return moveValue(settableRoot(in), from, to, true, options)
+}
+
+// Rename the key of all values matching the path.
+// The last segment of the path is the key to rename, while the preceding segments
+// match the parents of the key. Renaming a key into itself does nothing, and keys
+// are not renamed into a key that already exists.
+//
+// The value is moved into the new key of its parent, making this useful for maps
+// and for struct fields of compatible types. See Move for more details.
+//
+// `in` must be a pointer to a value or it will panic.
+func RenameKey(in any, mp []Matcher, newKey any, options ...TravellerOption) int {
+	n := len(mp)
+	if n == 0 {
+		return 0
+	}
+	if exact, ok := mp[n-1].(MatchExact); ok && reflect.DeepEqual(exact.Value, newKey) {
+		return 0
+	}
+
+	from := mp[n-1:]
+	to := []Matcher{MatchExact{Value: newKey}}
+
+	count := 0
+	cb := TravellerCallback{
+		OnTraversal: handleInaddrVals,
+		OnFound: func(f Found) bool {
+			if moveValue(f.RV(), from, to, false, options) {
+				count++
+			}
+			return true // Keep searching.
+		},
+	}
+
+	StartTraversal(settableRoot(in), mp[:n-1], cb, options...)
+	return count
+}
+
+// Move a value starting from the given settable value.
+// Without overwrite, nothing is moved if a value already exists in `to`.
+func moveValue(inRv reflect.Value, from, to []Matcher, overwrite bool, options []TravellerOption) bool {
+	var (
+		val      any
+		location Location
+		ok       bool
+	)
+
+	onFound := func(f Found) bool {
+		val, location, ok = f.RV().Interface(), f.Location(), true
+		return false // Stop searching on first match.
+	}
+
+	StartTraversal(inRv, from, TravellerCallback{OnFound: onFound}, options...)
+	if !ok {
+		return false
+	}
+
+	var t Traveller
+	t.applyOptions(options)
+
+	// A value cannot be moved into itself or into its own children, while the value
+	// moved into one of its parents replaces it instead of being deleted.
+	n := matchingPrefix(to, location, t.ParseKeys(), false)
+	if n == len(location) {
+		return false
+	}
+	replaced := n == len(to)
+
+	// Both steps are done on the value containing both locations, so that they see the
+	// changes of each other when the value is a copy written back into its parent.
+	// The last key of `to` is kept so that the value is not upserted into the value found.
+	if n = matchingPrefix(to, location, t.ParseKeys(), true); n == len(to) && n > 0 {
+		n--
+	}
+
+	moved := false
+	cb := TravellerCallback{
+		OnTraversal: handleInaddrVals,
+		OnFound: func(f Found) bool {
+			if !overwrite {
+				if _, exists := findFirst(f.RV(), to[n:], options); exists {
+					return false
+				}
+			}
+
+			count := 0
+			upsertBy(f.RV(), to[n:], func(any) (any, bool, bool) { return val, false, true }, &count, options)
+			if count > 0 && !replaced {
+				deleteAll(f.RV(), location[n:].Matchers(), options)
+			}
+			moved = count > 0
+			return false // Stop searching on first match.
+		},
+	}
+
+	StartTraversal(inRv, location[:n].Matchers(), cb, options...)
+	return moved
+}
+
+// Get the number of leading keys of the location that are matched by the path.
+// Unless exact, matchers other than MatchExact and MatchPattern are assumed to match any key.
+func matchingPrefix(mp []Matcher, location Location, parse, exact bool) int {
+	n := 0
+	for n < len(mp) && n < len(location) && matchesKey(mp[n], location[n], parse, exact) {
+		n++
+	}
+	return n
+}
+
+// Whether the matcher may match the key of a location.
+func matchesKey(m Matcher, key any, parse, exact bool) bool {
+	switch m := m.(type) {
+	case MatchExact:
+		return reflect.DeepEqual(m.Value, key) || parse && formatKey(m.Value) == formatKey(key)
+	case MatchPattern:
+		if _, ok := key.(string); exact || !ok && m.OnlyStringKey {
+			return false
+		}
+		return wild.Match(m.Pattern, formatKey(key), m.CaseInsensitive)
+	}
+	return !exact
+}
+
+// Find the first value matching the path.
+func findFirst(inRv reflect.Value, mp []Matcher, options []TravellerOption) (any, bool) {
+	var (
+		val any
+		ok  bool
+	)
+
+	onFound := func(f Found) bool {
+		val, ok = f.RV().Interface(), true
+		return false // Stop searching on first match.
+	}
+
+	StartTraversal(inRv, mp, TravellerCallback{OnFound: onFound}, options...)
+	return val, ok
+}
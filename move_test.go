@@ -0,0 +1,71 @@
+package traveller_test
+
+import "github.com/ezraisw/traveller"
+
+func (s GeneralTestSuite) TestCallMovePanic() {
+	s.Panics(func() {
+		traveller.Move(makeBulb(), traveller.P("Band"), traveller.P("Cup.Band"))
+	})
+}
+
+func (s GeneralTestSuite) TestCallMove() {
+	actual := makeBulb()
+	s.True(traveller.Move(&actual, traveller.P("Band"), traveller.P("Cup.Band")))
+	s.True(traveller.Move(&actual, traveller.P("Federation.Jet.Party"), traveller.P("Federation.Jet.Tiger")))
+	s.True(traveller.Move(&actual, traveller.P("Federation.Hate.Slide.Swipe.Deserted.Plain"), traveller.P("Federation.Hate.Slide.Swipe.Deserted.Meaning")))
+
+	expected := makeBulb()
+	expected.Cup["Band"] = expected.Band
+	expected.Band = ""
+	expected.Federation.Jet.Tiger = expected.Federation.Jet.Party
+	expected.Federation.Jet.Party = ""
+	expected.Federation.Hate.Slide.Swipe["Deserted"] = swipe{Meaning: "St1ABpJxt6l5ktcDnXs6", Peace: 99214}
+	s.Equal(expected, actual)
+}
+
+func (s GeneralTestSuite) TestCallMoveUnassignable() {
+	actual := makeBulb()
+	s.False(traveller.Move(&actual, traveller.P("Sunshine"), traveller.P("Band")))
+	s.False(traveller.Move(&actual, traveller.P("Nonexistent"), traveller.P("Band")))
+	s.Equal(makeBulb(), actual)
+}
+
+func (s GeneralTestSuite) TestCallMoveIntoItself() {
+	actual := makeBulb()
+	s.False(traveller.Move(&actual, traveller.P("Cup"), traveller.P("Cup")))
+	s.False(traveller.Move(&actual, traveller.P("Cup"), traveller.P("Cup.Houseplant")))
+	s.False(traveller.Move(&actual, traveller.P("Cup.Favour"), traveller.P("C*.Favour")))
+	s.Equal(makeBulb(), actual)
+}
+
+func (s GeneralTestSuite) TestCallMoveIntoParent() {
+	actual := map[string]any{"a": map[string]any{"b": map[string]any{"b": 1}}}
+	s.True(traveller.Move(&actual, traveller.P("a.b"), traveller.P("a")))
+	s.Equal(map[string]any{"a": map[string]any{"b": 1}}, actual)
+}
+
+func (s GeneralTestSuite) TestCallMoveFirstOfMany() {
+	actual := map[string]any{"a": map[string]any{"x": 1, "y": 2}}
+	s.True(traveller.Move(&actual, traveller.P("a.*"), traveller.P("b"), traveller.WithSortedMaps(true)))
+	s.Equal(map[string]any{"a": map[string]any{"y": 2}, "b": 1}, actual)
+}
+
+func (s GeneralTestSuite) TestCallRenameKey() {
+	actual := map[string]any{
+		"users": []any{
+			map[string]any{"fullName": "Jane", "age": 30},
+			map[string]any{"fullName": "John"},
+			map[string]any{"age": 20},
+		},
+	}
+	s.Equal(2, traveller.RenameKey(&actual, traveller.P("users.*.fullName"), "name"))
+	s.Equal(0, traveller.RenameKey(&actual, traveller.P("users.*.name"), "name"))
+	s.Equal(1, traveller.RenameKey(&actual, traveller.P("users.*.age"), "name"))
+	s.Equal(map[string]any{
+		"users": []any{
+			map[string]any{"name": "Jane", "age": 30},
+			map[string]any{"name": "John"},
+			map[string]any{"name": 20},
+		},
+	}, actual)
+}
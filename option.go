@@ -34,3 +34,110 @@ func WithIgnoreArray(ignoreArray bool) TravellerOption {
 		t.ignoreArray = ignoreArray
 	}
 }
+
+// Name struct fields using the value of the given struct tag, such as "json".
+// Fields without the tag keep their field name, while fields with the tag value
+// of "-" are not traversed.
+func WithTagName(tagName string) TravellerOption {
+	return func(t *Traveller) {
+		t.tagName = tagName
+	}
+}
+
+// Parse the string values of MatchExact into array/slice indexes and map keys of other types,
+// such as the segments of P("items.0") or P("scores.42").
+func WithParseKeys(parseKeys bool) TravellerOption {
+	return func(t *Traveller) {
+		t.parseKeys = parseKeys
+	}
+}
+
+// Traverse unexported struct fields.
+// The unexported fields can only be read unless WithUnexportedWritable is set.
+func WithUnexported(unexported bool) TravellerOption {
+	return func(t *Traveller) {
+		t.unexported = unexported
+	}
+}
+
+// Traverse unexported struct fields and allow setting them.
+// The fields are written through the unsafe package, bypassing the protection of
+// unexported fields. Fields of structs that are not addressable remain read-only.
+func WithUnexportedWritable(unexportedWritable bool) TravellerOption {
+	return func(t *Traveller) {
+		t.unexported = t.unexported || unexportedWritable
+		t.unexportedWritable = unexportedWritable
+	}
+}
+
+// Traverse map entries in the order of their keys, so that results are reproducible.
+// Keys of different kinds are ordered by their kind, then by their value.
+func WithSortedMaps(sortedMaps bool) TravellerOption {
+	return func(t *Traveller) {
+		t.sortedMaps = sortedMaps
+	}
+}
+
+// Traverse map entries in the order given by the comparator of their keys.
+// Implies WithSortedMaps.
+func WithMapKeyLess(less func(a, b any) bool) TravellerOption {
+	return func(t *Traveller) {
+		t.sortedMaps = t.sortedMaps || less != nil
+		t.mapKeyLess = less
+	}
+}
+
+// Set the order in which values are traversed.
+//
+// With BreadthFirst, Get returns the match closest to the root. The strategy only applies
+// to reads: traversals with the OnTraversal callback are always depth-first, which include
+// SetAll, UpdateAll, Upsert, Delete, Move, ApplyPatch, and the other functions modifying values.
+func WithStrategy(strategy Strategy) TravellerOption {
+	return func(t *Traveller) {
+		t.strategy = strategy
+	}
+}
+
+// Traverse using the given number of goroutines on GetAll, Count, Exists, AnyMatch, AllMatch,
+// and the numeric aggregations.
+//
+// The subtrees near the root are split into tasks for the goroutines. The values are
+// found in the same order as traversing on a single goroutine. Ignored with BreadthFirst.
+func WithParallelism(parallelism int) TravellerOption {
+	return func(t *Traveller) {
+		t.parallelism = parallelism
+	}
+}
+
+// Decode json.RawMessage values on demand during traversal,
+// so that the path can continue into their content.
+func WithDecodeRawJSON(decodeRawJSON bool) TravellerOption {
+	return func(t *Traveller) {
+		t.decodeRawJSON = decodeRawJSON
+	}
+}
+
+// Decode []byte values holding valid JSON on demand during traversal,
+// so that the path can continue into their content.
+func WithDecodeBytesJSON(decodeBytesJSON bool) TravellerOption {
+	return func(t *Traveller) {
+		t.decodeBytesJSON = decodeBytesJSON
+	}
+}
+
+// Encode the decoded JSON content back into its original value when it is modified.
+// The original value must be settable, which is the case when setting values.
+// Without it, setters do not go into the decoded content, as their modifications would be discarded.
+func WithEncodeRawJSON(encodeRawJSON bool) TravellerOption {
+	return func(t *Traveller) {
+		t.encodeRawJSON = encodeRawJSON
+	}
+}
+
+// Exclude the values matching any of the given paths from traversal.
+// The excluded values and everything inside them will never be found.
+func WithExclude(mps ...[]Matcher) TravellerOption {
+	return func(t *Traveller) {
+		t.exclude = append(t.exclude, mps...)
+	}
+}
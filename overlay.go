@@ -0,0 +1,314 @@
+package traveller
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The error that is returned when an overlay assignment is malformed.
+var ErrInvalidOverlay = errors.New("invalid overlay")
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	durationType        = reflect.TypeOf(time.Duration(0))
+)
+
+// A single value to overlay.
+type overlayEntry struct {
+	// The name of the entry for errors, such as the environment variable.
+	name  string
+	path  []string
+	value string
+}
+
+// Overlay the string values onto the value using their key as a path, such as "db.host".
+//
+// Struct fields are matched case-insensitively, ignoring underscores and dashes. Other segments
+// are parsed into indexes and map keys as with WithParseKeys. Missing map
+// entries and nil pointers are created as needed. The strings are converted into numerics,
+// bools, time.Duration, comma-separated slices, and types implementing encoding.TextUnmarshaler.
+//
+// The values are applied on a deep copy of the value, which is only assigned back on success.
+// ErrNotFound is returned for keys that do not match anything.
+//
+// `into` must be a pointer to a value or it will panic.
+func Overlay(into any, values map[string]string, options ...TravellerOption) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]overlayEntry, 0, len(keys))
+	for _, key := range keys {
+		entry, err := overlayPathEntry(key, values[key])
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+	}
+	return overlay(into, entries, false, options)
+}
+
+// Overlay the environment variables with the given prefix onto the value.
+//
+// Behaves the same as OverlayEnviron using the environment of the process.
+func OverlayEnv(into any, prefix string, options ...TravellerOption) error {
+	return OverlayEnviron(into, prefix, os.Environ(), options...)
+}
+
+// Overlay the variables of the environment in "KEY=value" form with the given prefix onto the value.
+//
+// The prefix is removed from the name of the variables, with double underscores separating
+// the path segments. For example, with the prefix "APP_", "APP_DB__MAX_CONNS" is applied to
+// the path "db.max_conns" which matches the field DB.MaxConns. The conversions are the same
+// as Overlay, but the variables that do not match anything are ignored.
+//
+// `into` must be a pointer to a value or it will panic.
+func OverlayEnviron(into any, prefix string, environ []string, options ...TravellerOption) error {
+	var entries []overlayEntry
+	for _, kv := range environ {
+		name, value, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(name, prefix) || len(name) == len(prefix) {
+			continue
+		}
+
+		path := strings.Split(strings.ToLower(name[len(prefix):]), "__")
+		entries = append(entries, overlayEntry{name: name, path: path, value: value})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+	return overlay(into, entries, true, options)
+}
+
+// Overlay the "--set path=value" and "--set=path=value" arguments onto the value.
+//
+// The arguments are applied in order, and the rest of the arguments are returned.
+// The conversions are the same as Overlay.
+//
+// `into` must be a pointer to a value or it will panic.
+func OverlayArgs(into any, args []string, options ...TravellerOption) ([]string, error) {
+	var (
+		entries []overlayEntry
+		rest    = make([]string, 0, len(args))
+	)
+	for i := 0; i < len(args); i++ {
+		var assignment string
+		switch arg := args[i]; {
+		case arg == "--set":
+			if i+1 == len(args) {
+				return nil, fmt.Errorf("overlay %s: %w", arg, ErrInvalidOverlay)
+			}
+			i++
+			assignment = args[i]
+		case strings.HasPrefix(arg, "--set="):
+			assignment = strings.TrimPrefix(arg, "--set=")
+		default:
+			rest = append(rest, arg)
+			continue
+		}
+
+		key, value, ok := strings.Cut(assignment, "=")
+		if !ok {
+			return nil, fmt.Errorf("overlay %q: %w", assignment, ErrInvalidOverlay)
+		}
+		entry, err := overlayPathEntry(key, value)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := overlay(into, entries, false, options); err != nil {
+		return nil, err
+	}
+	return rest, nil
+}
+
+// Create the entry of a string path which must only consist of exact segments.
+func overlayPathEntry(key, value string) (overlayEntry, error) {
+	mp, err := Path(key, false)
+	if err != nil {
+		return overlayEntry{}, fmt.Errorf("overlay %q: %w", key, err)
+	}
+
+	path := make([]string, 0, len(mp))
+	for _, m := range mp {
+		exact, ok := m.(MatchExact)
+		if !ok {
+			return overlayEntry{}, fmt.Errorf("overlay %q: %w", key, ErrInvalidPath)
+		}
+		path = append(path, exact.Value.(string))
+	}
+	return overlayEntry{name: key, path: path, value: value}, nil
+}
+
+// Apply the entries on a deep copy of the value, assigning it back on success.
+func overlay(into any, entries []overlayEntry, ignoreUnknown bool, options []TravellerOption) error {
+	inRv := settableRoot(into)
+
+	options = append([]TravellerOption{WithParseKeys(true)}, options...)
+	workRv := reflect.New(inRv.Type()).Elem()
+	workRv.Set(deepCopy(inRv))
+
+	for _, entry := range entries {
+		count, err := overlayValue(workRv, entry, options)
+		if err != nil {
+			return err
+		}
+		if count == 0 && !ignoreUnknown {
+			return fmt.Errorf("overlay %s: %w", entry.name, ErrNotFound)
+		}
+	}
+
+	inRv.Set(workRv)
+	return nil
+}
+
+// Upsert the value of the entry into rv, returning the number of assignments.
+func overlayValue(rv reflect.Value, entry overlayEntry, options []TravellerOption) (int, error) {
+	var (
+		count = 0
+		err   error
+		mp    = make([]Matcher, 0, len(entry.path))
+	)
+	for _, segment := range entry.path {
+		mp = append(mp, matchOverlay{matchUpsert: matchUpsert{MatchExact: MatchExact{Value: segment}, count: &count}})
+	}
+
+	cb := TravellerCallback{
+		OnTraversal: handleInaddrVals,
+		OnFound: func(f Found) bool {
+			valRv, ok := parseText(entry.value, f.RV().Type())
+			if !ok {
+				err = fmt.Errorf("overlay %s: %w", entry.name, ErrUnassignable)
+				return false
+			}
+			if !f.RV().CanSet() {
+				return true // Keep searching.
+			}
+			f.RV().Set(valRv)
+			count++
+			return false // Only overlay onto the first match.
+		},
+	}
+
+	StartTraversal(rv, mp, cb, options...)
+	return count, err
+}
+
+// Upsert match that matches struct fields case-insensitively, ignoring underscores and dashes.
+type matchOverlay struct {
+	matchUpsert
+}
+
+// Compile-time implementation check.
+var _ Matcher = (*matchOverlay)(nil)
+
+func (m matchOverlay) Match(rv reflect.Value, s MatcherSegment) bool {
+	isNilStructPtr := rv.Kind() == reflect.Ptr && rv.IsNil() && rv.Type().Elem().Kind() == reflect.Struct
+	if !isNilStructPtr && Unbox(rv).Kind() != reflect.Struct {
+		return m.matchUpsert.Match(rv, s)
+	}
+	if isNilStructPtr && !rv.CanSet() {
+		return true
+	}
+
+	before := *m.count
+	created := m.allocate(rv)
+	keepSearching := s.Traveller().matchNow(func() bool {
+		return m.matchStruct(Unbox(rv), s)
+	})
+
+	// Revert the allocations if nothing was assigned.
+	if created && *m.count == before {
+		rv.Set(reflect.Zero(rv.Type()))
+	}
+	return keepSearching
+}
+
+func (m matchOverlay) matchStruct(rv reflect.Value, s MatcherSegment) bool {
+	if s.Traveller().IgnoreStruct() {
+		return true
+	}
+	name := overlayKey(m.Value.(string))
+
+	rt := rv.Type()
+	for i := 0; i < rv.NumField(); i++ {
+		field := rt.Field(i)
+		fieldName, ok := s.Traveller().FieldName(field)
+		if !ok {
+			continue
+		}
+		fieldRv := s.Traveller().Field(rv, i)
+		if overlayKey(fieldName) == name && !s.Next(fieldRv, rv, field.Name) {
+			return false
+		}
+		// Check embedded values.
+		if !s.Traveller().NoFlatEmbeds() && field.Anonymous && !s.Stay(fieldRv, rv, field.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize a key for case-insensitive comparison, ignoring underscores and dashes.
+func overlayKey(key string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+}
+
+// Parse a string into a value of the given type.
+//
+// Besides the kinds supported by parseString, time.Duration, comma-separated slices,
+// pointers, interfaces, and types implementing encoding.TextUnmarshaler are supported.
+func parseText(str string, typ reflect.Type) (reflect.Value, bool) {
+	if reflect.PtrTo(typ).Implements(textUnmarshalerType) {
+		rv := reflect.New(typ)
+		if err := rv.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(str)); err != nil {
+			return reflect.Value{}, false
+		}
+		return rv.Elem(), true
+	}
+	if typ == durationType {
+		d, err := time.ParseDuration(str)
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(d), true
+	}
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		elemRv, ok := parseText(str, typ.Elem())
+		if !ok {
+			return reflect.Value{}, false
+		}
+		rv := reflect.New(typ.Elem())
+		rv.Elem().Set(elemRv)
+		return rv, true
+	case reflect.Interface:
+		return assignableValue(str, typ)
+	case reflect.Slice:
+		rv := reflect.MakeSlice(typ, 0, 0)
+		if str == "" {
+			return rv, true
+		}
+		for _, part := range strings.Split(str, ",") {
+			elemRv, ok := parseText(strings.TrimSpace(part), typ.Elem())
+			if !ok {
+				return reflect.Value{}, false
+			}
+			rv = reflect.Append(rv, elemRv)
+		}
+		return rv, true
+	}
+	return parseString(str, typ)
+}
@@ -0,0 +1,130 @@
+package traveller_test
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	"github.com/ezraisw/traveller"
+)
+
+func (s GeneralTestSuite) TestCallOverlay() {
+	in := makeBulb()
+	err := traveller.Overlay(&in, map[string]string{
+		"sunshine":                     "122",
+		"barrel":                       "0.5",
+		"inheritance":                  "1",
+		"worth":                        "a, b",
+		"federation.clean":             "80,443",
+		"federation.decline.Victory":   "edited",
+		"federation.decline.team":      "core",
+		"federation.jet.tiger":         "edited",
+		"federation.hate.traction.cpu": "2",
+		"federation.hate.create.fence.knowledge.tire.social": "1.5,2",
+	})
+	s.Require().NoError(err)
+
+	expected := makeBulb()
+	expected.Sunshine = 122
+	expected.Barrel = 0.5
+	expected.Inheritance = 1
+	expected.Worth = []string{"a", "b"}
+	expected.Federation.Clean = []int{80, 443}
+	expected.Federation.Decline = map[string]string{"Victory": "edited", "Instinct": "8bJD76KwNbdBMZE6L1ex", "team": "core"}
+	expected.Federation.Jet.Tiger = "edited"
+	expected.Federation.Hate.Traction["cpu"] = 2
+	expected.Federation.Hate.Create.Fence.Knowledge.Tire.Social = []float64{1.5, 2}
+	s.Equal(expected, in)
+}
+
+func (s GeneralTestSuite) TestCallOverlayTypes() {
+	var cfg struct {
+		Debug    bool
+		Port     uint16
+		MaxConns int
+		Limit    int `json:"max_limit"`
+		Timeout  time.Duration
+		Bind     net.IP
+		Optional *int
+		Next     *chain
+	}
+	err := traveller.Overlay(&cfg, map[string]string{
+		"debug":     "true",
+		"port":      "5432",
+		"max-conns": "10",
+		"limit":     "20",
+		"timeout":   "1m30s",
+		"bind":      "127.0.0.1",
+		"optional":  "7",
+		"next.name": "next",
+	})
+	s.Require().NoError(err)
+
+	s.True(cfg.Debug)
+	s.Equal(uint16(5432), cfg.Port)
+	s.Equal(10, cfg.MaxConns)
+	s.Equal(20, cfg.Limit)
+	s.Equal(90*time.Second, cfg.Timeout)
+	s.Equal(net.ParseIP("127.0.0.1"), cfg.Bind)
+	s.Equal(7, *cfg.Optional)
+	s.Equal(&chain{Name: "next"}, cfg.Next)
+
+	s.Require().NoError(traveller.OverlayEnviron(&cfg, "APP_", []string{"APP_MAX_LIMIT=5"}, traveller.WithTagName("json")))
+	s.Equal(5, cfg.Limit)
+}
+
+func (s GeneralTestSuite) TestCallOverlayErrors() {
+	in := makeBulb()
+
+	err := traveller.Overlay(&in, map[string]string{"band": "other", "sunshine": "high"})
+	s.True(errors.Is(err, traveller.ErrUnassignable))
+	s.Equal(makeBulb(), in)
+
+	err = traveller.Overlay(&in, map[string]string{"missing": "x"})
+	s.True(errors.Is(err, traveller.ErrNotFound))
+
+	err = traveller.Overlay(&in, map[string]string{"cup.*": "x"})
+	s.True(errors.Is(err, traveller.ErrInvalidPath))
+}
+
+func (s GeneralTestSuite) TestCallOverlayEnviron() {
+	in := makeBulb()
+	err := traveller.OverlayEnviron(&in, "APP_", []string{
+		"APP_FEDERATION__JET__PARTY=edited",
+		"APP_FEDERATION__HATE__SLIDE__MILL=1,2",
+		"APP_CUP__TEAM=core",
+		"APP_UNKNOWN=ignored",
+		"APP_=ignored",
+		"HOME=/root",
+	})
+	s.Require().NoError(err)
+
+	expected := makeBulb()
+	expected.Federation.Jet.Party = "edited"
+	expected.Federation.Hate.Slide.Mill = []int{1, 2}
+	expected.Cup["team"] = "core"
+	s.Equal(expected, in)
+
+	// Nothing is created for variables that do not match anything.
+	var empty chain
+	s.Require().NoError(traveller.OverlayEnviron(&empty, "APP_", []string{"APP_NEXT__UNKNOWN=x"}))
+	s.Nil(empty.Next)
+
+	s.T().Setenv("TEST_OVERLAY_SUNSHINE", "1")
+	s.Require().NoError(traveller.OverlayEnv(&in, "TEST_OVERLAY_"))
+	s.Equal(1, in.Sunshine)
+}
+
+func (s GeneralTestSuite) TestCallOverlayArgs() {
+	in := makeBulb()
+	rest, err := traveller.OverlayArgs(&in, []string{"serve", "--set", "band=a", "--verbose", "--set=band=b", "--set=cup.key=x=y"})
+	s.Require().NoError(err)
+	s.Equal([]string{"serve", "--verbose"}, rest)
+	s.Equal("b", in.Band)
+	s.Equal("x=y", in.Cup["key"])
+
+	_, err = traveller.OverlayArgs(&in, []string{"--set"})
+	s.True(errors.Is(err, traveller.ErrInvalidOverlay))
+	_, err = traveller.OverlayArgs(&in, []string{"--set", "band"})
+	s.True(errors.Is(err, traveller.ErrInvalidOverlay))
+}
@@ -0,0 +1,183 @@
+package traveller
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// The minimum number of tasks split for each goroutine of parallel traversal,
+// so that the ranges of tasks given to the goroutines are balanced.
+const parallelTasksPerWorker = 4
+
+// The maximum depth that is split into tasks for parallel traversal.
+const maxParallelSplitDepth = 8
+
+// The bound of the range that counts the matches of a value without visiting any of them.
+const maxInt = int(^uint(0) >> 1)
+
+// Find the values matching the path, traversing with the goroutines given by WithParallelism.
+//
+// The value returned by onFound is collected if the second value is true. Returning false as the
+// third value stops the traversal. The values are collected in the same order as traversing on a
+// single goroutine, although onFound may be called concurrently and stopping is not immediate.
+func findAll[R any](rv reflect.Value, mp []Matcher, onFound func(Found) (R, bool, bool), options []TravellerOption) []R {
+	t := &Traveller{mp: mp}
+	t.applyOptions(options)
+	t.excluded = t.findExcluded(rv)
+
+	if t.parallelism <= 1 || t.strategy == BreadthFirst {
+		results := make([]R, 0)
+		t.cb = TravellerCallback{
+			OnFound: func(f Found) bool {
+				result, ok, keepSearching := onFound(f)
+				if ok {
+					results = append(results, result)
+				}
+				return keepSearching
+			},
+		}
+		t.traverse(rv)
+		return results
+	}
+
+	tasks := t.splitTasks(pendingMatch{rv: rv, trail: t.root()})
+	workers := t.parallelism
+	if workers > len(tasks) {
+		workers = len(tasks)
+	}
+	workerResults := make([][]R, workers)
+
+	var (
+		wg      sync.WaitGroup
+		stopped int32
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		// Each goroutine visits a contiguous range of the tasks, so that the results of the
+		// goroutines are in traversal order when joined.
+		go func(w int) {
+			defer wg.Done()
+
+			worker := t.sub(mp, TravellerCallback{
+				OnFound: func(f Found) bool {
+					result, ok, keepSearching := onFound(f)
+					if ok {
+						workerResults[w] = append(workerResults[w], result)
+					}
+					return keepSearching && atomic.LoadInt32(&stopped) == 0
+				},
+			})
+
+			end := len(tasks) * (w + 1) / workers
+			for i := len(tasks) * w / workers; i < end && atomic.LoadInt32(&stopped) == 0; i++ {
+				worker.split = tasks[i].split
+				if !worker.iterate(&tasks[i].match) {
+					atomic.StoreInt32(&stopped, 1)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	results := make([]R, 0)
+	for _, r := range workerResults {
+		results = append(results, r...)
+	}
+	return results
+}
+
+// A part of the parallel traversal, visited by one of the goroutines.
+type parallelTask struct {
+	match pendingMatch
+
+	// The range of the matches made directly from the value of the match when the value is split
+	// between several tasks, so that wide values are split without deferring each of their matches.
+	split *matchRange
+}
+
+// A range of the matches made directly from a value, in the order they are made.
+// The matches outside of the range are skipped.
+type matchRange struct {
+	// The trail of the value.
+	trail *trail
+
+	lo, hi int
+
+	// The number of matches made so far.
+	n int
+}
+
+// Whether the next match is in the range, counting it.
+func (r *matchRange) next() bool {
+	i := r.n
+	r.n++
+	return i >= r.lo && i < r.hi
+}
+
+// Split the root match into the tasks of the subtrees near the root, in traversal order.
+//
+// The matches are split level by level until there are enough tasks for the goroutines. A match
+// with enough matches inside is split into ranges of them, otherwise into the matches themselves.
+// Matches at the end of the path are kept as is, as visiting them reports the found value,
+// and so are the matches after the ones that split into enough tasks.
+func (t *Traveller) splitTasks(root pendingMatch) []parallelTask {
+	splitter := t.sub(t.mp, TravellerCallback{})
+	splitter.iterating, splitter.stepwise = true, true
+	counter := t.sub(t.mp, TravellerCallback{})
+
+	target := t.parallelism * parallelTasksPerWorker
+	tasks, next := []parallelTask{{match: root}}, []parallelTask(nil)
+	for depth := 0; depth < maxParallelSplitDepth && len(tasks) < target; depth++ {
+		split := false
+		next = next[:0]
+		for i, task := range tasks {
+			p := task.match
+			need := target - len(next) - (len(tasks) - i - 1)
+			if task.split != nil || p.index == len(t.mp) || need <= 1 {
+				next = append(next, task)
+				continue
+			}
+			split = true
+
+			// Only values whose matches are made in the same order every time are split into ranges.
+			if t.orderedMatches(p.rv) {
+				r := matchRange{trail: p.trail, lo: maxInt, hi: maxInt}
+				counter.split = &r
+				counter.resume(&p)
+				if r.n >= need {
+					for j := 0; j < need; j++ {
+						next = append(next, parallelTask{
+							match: p,
+							split: &matchRange{trail: p.trail, lo: r.n * j / need, hi: r.n * (j + 1) / need},
+						})
+					}
+					continue
+				}
+			}
+
+			splitter.pending = splitter.pending[:0]
+			splitter.resume(&p)
+			for _, q := range splitter.pending {
+				next = append(next, parallelTask{match: q})
+			}
+		}
+		tasks, next = next, tasks
+		if !split {
+			break
+		}
+	}
+	return tasks
+}
+
+// Whether the matches made directly from the value are made in the same order on every visit,
+// which is not the case for maps unless they are sorted.
+func (t *Traveller) orderedMatches(rv reflect.Value) bool {
+	if _, _, ok := asTraversable(rv); ok {
+		return true
+	}
+	if t.decodeRawJSON || t.decodeBytesJSON {
+		return false
+	}
+	return t.sortedMaps || Unbox(rv).Kind() != reflect.Map
+}
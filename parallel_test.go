@@ -0,0 +1,31 @@
+package traveller_test
+
+import "github.com/ezraisw/traveller"
+
+func (s GeneralTestSuite) TestCallGetAllParallel() {
+	in := makeWideInput(1000)
+
+	for _, path := range []string{"items.*.name", "**", "items.*.tags.*", "**.id"} {
+		sorted := traveller.WithSortedMaps(true)
+		expected := traveller.GetAll[any](in, traveller.P(path), sorted)
+		s.Equal(expected, traveller.GetAll[any](in, traveller.P(path), sorted, traveller.WithParallelism(4)), path)
+	}
+
+	// Maps that are not sorted are traversed in any order.
+	byName := make(map[string]any)
+	for _, item := range traveller.GetAll[map[string]any](in, traveller.P("items.*")) {
+		byName[item["name"].(string)] = item
+	}
+	s.ElementsMatch(traveller.GetAll[any](byName, traveller.P("*.id")), traveller.GetAll[any](byName, traveller.P("*.id"), traveller.WithParallelism(4)))
+
+	ids := traveller.GetAll[int](in, traveller.P("items.*.id"), traveller.WithParallelism(4), traveller.WithExclude(traveller.P("items.0")), traveller.WithParseKeys(true))
+	s.Len(ids, 999)
+	s.Equal(1, ids[0])
+	s.Equal(999, ids[998])
+}
+
+func (s GeneralTestSuite) TestCallGetAllParallelSmall() {
+	s.Equal([]string{"a"}, traveller.GetAll[string]("a", []traveller.Matcher{}, traveller.WithParallelism(4)))
+	s.Equal([]string{"b"}, traveller.GetAll[string]([]any{1, "b"}, traveller.P("*"), traveller.WithParallelism(4)))
+	s.Empty(traveller.GetAll[string]([]any{}, traveller.P("**"), traveller.WithParallelism(4)))
+}
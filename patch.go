@@ -0,0 +1,297 @@
+package traveller
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// The error that is returned when a patch operation is malformed.
+	ErrInvalidPatch = errors.New("invalid patch operation")
+
+	// The error that is returned when the value of a "test" patch operation does not match.
+	ErrTestFailed = errors.New("patch test failed")
+)
+
+// A single JSON Patch (RFC 6902) operation.
+type PatchOp struct {
+	// The operation, which is one of "add", "remove", "replace", "move", "copy", or "test".
+	Op string `json:"op"`
+
+	// The JSON Pointer of the target location.
+	Path string `json:"path"`
+
+	// The JSON Pointer of the source location for "move" and "copy".
+	From string `json:"from,omitempty"`
+
+	// The value for "add", "replace", and "test".
+	Value any `json:"value"`
+}
+
+// Apply a JSON Patch (RFC 6902) to the given value.
+//
+// Struct fields are named by their json tag by default, which can be changed through
+// WithTagName. Reference tokens are parsed into indexes and map keys (WithParseKeys).
+// Values are converted into the type of their target, such as float64 into int and
+// map[string]any into structs, as long as no information is lost.
+//
+// The operations are applied on a deep copy of the value, which is only assigned back
+// if all operations succeed. Arrays cannot grow, so adding into an array replaces the element.
+//
+// `in` must be a pointer to a value or it will panic.
+func ApplyPatch(in any, ops []PatchOp, options ...TravellerOption) error {
+	inRv := settableRoot(in)
+
+	options = append([]TravellerOption{WithTagName("json"), WithParseKeys(true)}, options...)
+	traveller := &Traveller{}
+	traveller.applyOptions(options)
+
+	p := patcher{
+		traveller: traveller,
+		rootRv:    reflect.New(inRv.Type()).Elem(),
+		options:   options,
+	}
+	p.rootRv.Set(deepCopy(inRv))
+
+	for i, op := range ops {
+		if err := p.apply(op); err != nil {
+			return fmt.Errorf("patch operation %d (%s %s): %w", i, op.Op, op.Path, err)
+		}
+	}
+
+	inRv.Set(p.rootRv)
+	return nil
+}
+
+// Applier of patch operations on a settable value.
+type patcher struct {
+	traveller *Traveller
+	rootRv    reflect.Value
+	options   []TravellerOption
+}
+
+func (p patcher) apply(op PatchOp) error {
+	path, err := splitPointer(op.Path)
+	if err != nil {
+		return err
+	}
+
+	switch op.Op {
+	case "add":
+		return p.add(path, op.Value)
+	case "remove":
+		return p.remove(path)
+	case "replace":
+		return p.replace(path, op.Value)
+	case "test":
+		return p.test(path, op.Value)
+	case "move", "copy":
+		from, err := splitPointer(op.From)
+		if err != nil {
+			return err
+		}
+		if op.Op == "move" {
+			return p.move(from, path)
+		}
+		return p.copy(from, path)
+	}
+	return ErrInvalidPatch
+}
+
+// Add the value into the location, creating the map entry or inserting into the slice.
+func (p patcher) add(path []string, val any) error {
+	if len(path) == 0 {
+		return p.setRoot(val)
+	}
+
+	var (
+		count = 0
+		err   error
+	)
+
+	mp := pointerMatchers(path)
+	mp[len(mp)-1] = matchPatchAdd{
+		matchUpsert: matchUpsert{MatchExact: MatchExact{Value: path[len(path)-1]}, count: &count},
+		traveller:   p.traveller,
+		val:         val,
+		err:         &err,
+	}
+	return p.set(mp, val, &count, &err)
+}
+
+// Remove the value of the location.
+func (p patcher) remove(path []string) error {
+	if len(path) == 0 {
+		p.rootRv.Set(reflect.Zero(p.rootRv.Type()))
+		return nil
+	}
+	if deleteAll(p.rootRv, pointerMatchers(path), p.options) == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Replace the existing value of the location.
+func (p patcher) replace(path []string, val any) error {
+	if len(path) == 0 {
+		return p.setRoot(val)
+	}
+
+	var (
+		count = 0
+		err   error
+	)
+	return p.set(pointerMatchers(path), val, &count, &err)
+}
+
+// Check whether the value of the location equals to the given value.
+func (p patcher) test(path []string, val any) error {
+	actual, err := p.get(path)
+	if err != nil {
+		return err
+	}
+	if actual == nil {
+		if val != nil {
+			return ErrTestFailed
+		}
+		return nil
+	}
+
+	valRv, ok := p.traveller.convertValue(val, reflect.TypeOf(actual))
+	if !ok || !reflect.DeepEqual(valRv.Interface(), actual) {
+		return ErrTestFailed
+	}
+	return nil
+}
+
+// Remove the value of a location and add it into another location.
+func (p patcher) move(from, path []string) error {
+	val, err := p.get(from)
+	if err != nil {
+		return err
+	}
+
+	// A value cannot be moved into itself or into its own children.
+	if isPointerPrefix(from, path) {
+		if len(from) == len(path) {
+			return nil
+		}
+		return ErrInvalidPatch
+	}
+	if err := p.remove(from); err != nil {
+		return err
+	}
+	return p.add(path, val)
+}
+
+// Add a deep copy of the value of a location into another location.
+func (p patcher) copy(from, path []string) error {
+	val, err := p.get(from)
+	if err != nil {
+		return err
+	}
+	if val != nil {
+		val = deepCopy(reflect.ValueOf(val)).Interface()
+	}
+	return p.add(path, val)
+}
+
+// Get the value of the location.
+func (p patcher) get(path []string) (any, error) {
+	if len(path) == 0 {
+		return p.rootRv.Interface(), nil
+	}
+	val, ok := findFirst(p.rootRv, pointerMatchers(path), p.options)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return val, nil
+}
+
+// Set the converted value into the first value matching the path.
+func (p patcher) set(mp []Matcher, val any, count *int, err *error) error {
+	cb := TravellerCallback{
+		OnTraversal: handleInaddrVals,
+		OnFound: func(f Found) bool {
+			valRv, ok := p.traveller.convertValue(val, f.RV().Type())
+			if !ok {
+				*err = ErrUnassignable
+				return false
+			}
+			f.RV().Set(valRv)
+			*count++
+			return false // Only set the first match.
+		},
+	}
+
+	StartTraversal(p.rootRv, mp, cb, p.options...)
+	if *err != nil {
+		return *err
+	}
+	if *count == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (p patcher) setRoot(val any) error {
+	valRv, ok := p.traveller.convertValue(val, p.rootRv.Type())
+	if !ok {
+		return ErrUnassignable
+	}
+	p.rootRv.Set(valRv)
+	return nil
+}
+
+// The last segment of an add operation.
+// Values are inserted into slices instead of replacing the element.
+type matchPatchAdd struct {
+	matchUpsert
+
+	traveller *Traveller
+	val       any
+	err       *error
+}
+
+// Compile-time implementation check.
+var _ Matcher = (*matchPatchAdd)(nil)
+
+func (m matchPatchAdd) Match(rv reflect.Value, s MatcherSegment) bool {
+	sliceRv := Unbox(rv)
+	if sliceRv.Kind() != reflect.Slice || s.Traveller().IgnoreArray() {
+		return m.matchUpsert.Match(rv, s)
+	}
+
+	// The index of "-" refers to the end of the slice.
+	index := -1
+	if m.Value != "-" {
+		i, ok := indexKey(m.Value, true)
+		if !ok || i > sliceRv.Len() {
+			*m.err = ErrNotFound
+			return false
+		}
+		index = i
+	}
+
+	valRv, ok := m.traveller.convertValue(m.val, sliceRv.Type().Elem())
+	if !ok || !insertSlice(rv, index, []any{valRv.Interface()}) {
+		*m.err = ErrUnassignable
+		return false
+	}
+	*m.count++
+	return false
+}
+
+// Whether the tokens of the prefix are the beginning of the tokens of the path.
+func isPointerPrefix(prefix, path []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
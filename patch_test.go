@@ -0,0 +1,89 @@
+package traveller_test
+
+import (
+	"encoding/json"
+
+	"github.com/ezraisw/traveller"
+)
+
+func (s GeneralTestSuite) TestCallApplyPatch() {
+	var ops []traveller.PatchOp
+	s.Require().NoError(json.Unmarshal([]byte(`[
+		{"op": "test", "path": "/Band", "value": "dWoZA2QqGf9An6Ew25eC"},
+		{"op": "replace", "path": "/Sunshine", "value": 122},
+		{"op": "replace", "path": "/Headache/4", "value": 1},
+		{"op": "add", "path": "/Worth/1", "value": "dev"},
+		{"op": "add", "path": "/Worth/-", "value": "last"},
+		{"op": "remove", "path": "/Worth/0"},
+		{"op": "add", "path": "/Federation/Hate/Slide/Swipe/Added", "value": {"plain": "Nice", "peace": 6000}},
+		{"op": "add", "path": "/Cup/lang", "value": "fr"},
+		{"op": "move", "path": "/Cup/moved", "from": "/Cup/a~1b"},
+		{"op": "copy", "path": "/Cup/barrel", "from": "/Federation/jet/barrel"}
+	]`), &ops))
+
+	in := makeBulb()
+	in.Cup["a/b"] = 1
+	s.Require().NoError(traveller.ApplyPatch(&in, ops))
+
+	expected := makeBulb()
+	expected.Sunshine = 122
+	expected.Headache[4] = 1
+	expected.Worth = []string{"dev", "WgNTqZEG6KuSnqCocyiV", "RGnXLTPCadctoltAPnXs", "last"}
+	expected.Federation.Hate.Slide.Swipe["Added"] = swipe{Plain: "Nice", Peace: 6000}
+	expected.Cup["lang"] = "fr"
+	expected.Cup["moved"] = 1
+	expected.Cup["barrel"] = map[string]int{"Outside": 34, "Pumpkin": 420}
+	s.Equal(expected, in)
+
+	// Copies do not share values.
+	in.Federation.Jet.Barrel.(map[string]int)["Outside"] = 1
+	s.Equal(map[string]int{"Outside": 34, "Pumpkin": 420}, in.Cup["barrel"])
+}
+
+func (s GeneralTestSuite) TestCallApplyPatchError() {
+	in := makeBulb()
+
+	cases := []struct {
+		op  traveller.PatchOp
+		err error
+	}{
+		{traveller.PatchOp{Op: "test", Path: "/Band", Value: "Bob"}, traveller.ErrTestFailed},
+		{traveller.PatchOp{Op: "replace", Path: "/missing", Value: 1}, traveller.ErrNotFound},
+		{traveller.PatchOp{Op: "replace", Path: "/Sunshine", Value: 1.5}, traveller.ErrUnassignable},
+		{traveller.PatchOp{Op: "add", Path: "/Worth/5", Value: "x"}, traveller.ErrNotFound},
+		{traveller.PatchOp{Op: "add", Path: "/missing/key", Value: "x"}, traveller.ErrNotFound},
+		{traveller.PatchOp{Op: "remove", Path: "/Cup/missing"}, traveller.ErrNotFound},
+		{traveller.PatchOp{Op: "remove", Path: "/Federation/jet/Tiger"}, traveller.ErrNotFound},
+		{traveller.PatchOp{Op: "move", Path: "/Federation/jet/party", From: "/Federation/jet"}, traveller.ErrInvalidPatch},
+		{traveller.PatchOp{Op: "unknown", Path: "/Band"}, traveller.ErrInvalidPatch},
+		{traveller.PatchOp{Op: "add", Path: "Band", Value: "x"}, traveller.ErrInvalidPath},
+		{traveller.PatchOp{Op: "add", Path: "/Cup/~2", Value: "x"}, traveller.ErrInvalidPath},
+	}
+
+	for _, c := range cases {
+		err := traveller.ApplyPatch(&in, []traveller.PatchOp{
+			{Op: "replace", Path: "/Band", Value: "Changed"},
+			c.op,
+		})
+		s.ErrorIs(err, c.err, c.op)
+	}
+
+	// Nothing is applied when an operation fails.
+	s.Equal(makeBulb(), in)
+}
+
+func (s GeneralTestSuite) TestCallApplyPatchMap() {
+	in := map[string]any{"list": []any{1.0, 2.0}}
+	err := traveller.ApplyPatch(&in, []traveller.PatchOp{
+		{Op: "add", Path: "/list/0", Value: 0.0},
+		{Op: "add", Path: "/nested", Value: map[string]any{"key": "value"}},
+		{Op: "replace", Path: "/nested/key", Value: "replaced"},
+		{Op: "test", Path: "/list", Value: []any{0.0, 1.0, 2.0}},
+	})
+	s.NoError(err)
+	s.Equal(map[string]any{"list": []any{0.0, 1.0, 2.0}, "nested": map[string]any{"key": "replaced"}}, in)
+
+	err = traveller.ApplyPatch(&in, []traveller.PatchOp{{Op: "replace", Path: "", Value: map[string]any{}}})
+	s.NoError(err)
+	s.Equal(map[string]any{}, in)
+}
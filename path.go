@@ -39,24 +39,33 @@ func MustPath(ps string, caseInsensitive bool) []Matcher {
 }
 
 // Convert a string path to a series of matchers.
+//
+// Segments are separated by dots. A backslash escapes the character after it, such as
+// a dot or a backslash that is part of a key. An escaped asterisk is part of the key
+// instead of a wildcard, which is only valid in segments that are matched exactly, that is
+// segments without other asterisks when not case insensitive.
 func Path(ps string, caseInsensitive bool) ([]Matcher, error) {
-	tokens := splitEscape(ps, '.', '\\')
+	tokens := splitPath(ps)
 	matchers := make([]Matcher, 0, len(tokens))
 	for _, token := range tokens {
-		if isExactToken(token) {
+		if token.literal && (token.wild || caseInsensitive) {
+			return nil, ErrInvalidPath
+		}
+
+		if !token.wild {
 			if !caseInsensitive {
-				matchers = append(matchers, MatchExact{Value: token})
+				matchers = append(matchers, MatchExact{Value: token.value})
 			} else {
 				matchers = append(matchers, MatchPattern{
-					Pattern:         token,
+					Pattern:         token.value,
 					CaseInsensitive: caseInsensitive,
 				})
 			}
-		} else if isMultiMatchToken(token) {
+		} else if isMultiMatchToken(token.value) {
 			matchers = append(matchers, MatchMulti{})
-		} else if !isInvalidToken(token) {
+		} else if !isInvalidToken(token.value) {
 			matchers = append(matchers, MatchPattern{
-				Pattern:         token,
+				Pattern:         token.value,
 				CaseInsensitive: caseInsensitive,
 			})
 		} else {
@@ -66,11 +75,6 @@ func Path(ps string, caseInsensitive bool) ([]Matcher, error) {
 	return matchers, nil
 }
 
-// Whether the token is an exact match value.
-func isExactToken(token string) bool {
-	return !strings.Contains(token, "*")
-}
-
 // Whether the token is a multi match/recursive match value.
 func isMultiMatchToken(token string) bool {
 	return token == "**"
@@ -81,26 +85,49 @@ func isInvalidToken(token string) bool {
 	return strings.Contains(token, "**") && len(token) != 2
 }
 
-// Splits a string to a collection of token by the given separator.
-//
-// Will not attempt to split when a separator is preceded by
-// the specified escape character.
-func splitEscape(s string, separator, escape byte) []string {
+// Escape the dots, asterisks, and backslashes of a key with a backslash,
+// so that Path matches it exactly.
+func escapePathKey(key string) string {
+	var sb strings.Builder
+	for i := 0; i < len(key); i++ {
+		if c := key[i]; c == '.' || c == '*' || c == '\\' {
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(key[i])
+	}
+	return sb.String()
+}
+
+// A segment of a string path with the escapes removed.
+type pathToken struct {
+	value string
+
+	// Whether the segment contains asterisks that are wildcards, and asterisks that are escaped.
+	wild, literal bool
+}
+
+// Splits a string path into its segments by the dots that are not escaped.
+func splitPath(ps string) []pathToken {
 	var (
-		token  []byte
-		tokens []string
+		token  pathToken
+		value  []byte
+		tokens []pathToken
 	)
-	for i := 0; i < len(s); i++ {
-		if s[i] == separator {
-			tokens = append(tokens, string(token))
-			token = token[:0]
-		} else if s[i] == escape && i+1 < len(s) {
+	for i := 0; i < len(ps); i++ {
+		switch c := ps[i]; {
+		case c == '.':
+			token.value = string(value)
+			tokens = append(tokens, token)
+			token, value = pathToken{}, value[:0]
+		case c == '\\' && i+1 < len(ps):
 			i++
-			token = append(token, s[i])
-		} else {
-			token = append(token, s[i])
+			token.literal = token.literal || ps[i] == '*'
+			value = append(value, ps[i])
+		default:
+			token.wild = token.wild || c == '*'
+			value = append(value, c)
 		}
 	}
-	tokens = append(tokens, string(token))
-	return tokens
+	token.value = string(value)
+	return append(tokens, token)
 }
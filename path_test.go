@@ -100,6 +100,21 @@ func (s PathTestSuite) TestCallPath() {
 			caseInsensitive: false,
 			expected:        []traveller.Matcher{traveller.MatchPattern{Pattern: "nested1.*nest*"}},
 		},
+		{
+			in:              "some\\*.\\*\\*.back\\\\slash",
+			caseInsensitive: false,
+			expected:        []traveller.Matcher{traveller.MatchExact{Value: "some*"}, traveller.MatchExact{Value: "**"}, traveller.MatchExact{Value: "back\\slash"}},
+		},
+		{
+			in:              "some\\**",
+			caseInsensitive: false,
+			err:             traveller.ErrInvalidPath,
+		},
+		{
+			in:              "some\\*",
+			caseInsensitive: true,
+			err:             traveller.ErrInvalidPath,
+		},
 
 		// Case insensitive.
 		{
@@ -151,3 +166,21 @@ func (s PathTestSuite) TestCallPath() {
 		})
 	}
 }
+
+func (s PathTestSuite) TestCallLocationString() {
+	location := traveller.Location{"some.key", 12, "back\\slash", true}
+	s.Equal("some\\.key.12.back\\\\slash.true", location.String())
+
+	mp := traveller.P(location.String())
+	s.Equal([]traveller.Matcher{
+		traveller.MatchExact{Value: "some.key"},
+		traveller.MatchExact{Value: "12"},
+		traveller.MatchExact{Value: "back\\slash"},
+		traveller.MatchExact{Value: "true"},
+	}, mp)
+
+	// Asterisks are escaped to be matched exactly.
+	location = traveller.Location{"some*", "**"}
+	s.Equal("some\\*.\\*\\*", location.String())
+	s.Equal(location.Matchers(), traveller.P(location.String()))
+}
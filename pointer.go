@@ -0,0 +1,68 @@
+package traveller
+
+import "strings"
+
+var (
+	// The replacers for escaping and unescaping reference tokens of a JSON Pointer.
+	pointerEscaper   = strings.NewReplacer("~", "~0", "/", "~1")
+	pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// Convert a JSON Pointer (RFC 6901) to a series of exact matchers.
+//
+// Will panic if the given pointer is invalid.
+// Use Pointer if an invalid input is expected.
+func MustPointer(ptr string) []Matcher {
+	mp, err := Pointer(ptr)
+	if err != nil {
+		panic(err)
+	}
+	return mp
+}
+
+// Convert a JSON Pointer (RFC 6901) to a series of exact matchers.
+//
+// Every reference token is matched exactly, with "~1" and "~0" unescaped into "/" and "~".
+// The empty pointer refers to the value itself and results in an empty path.
+//
+// As with P, tokens such as "0" only refer to array/slice indexes and non-string map keys
+// with WithParseKeys. Without it, they only match struct fields and string map keys.
+func Pointer(ptr string) ([]Matcher, error) {
+	tokens, err := splitPointer(ptr)
+	if err != nil {
+		return nil, err
+	}
+	return pointerMatchers(tokens), nil
+}
+
+// Split a JSON Pointer (RFC 6901) into its unescaped reference tokens.
+// The empty pointer refers to the whole value and results in no tokens.
+func splitPointer(ptr string) ([]string, error) {
+	if ptr == "" {
+		return nil, nil
+	}
+	if ptr[0] != '/' {
+		return nil, ErrInvalidPath
+	}
+
+	tokens := strings.Split(ptr[1:], "/")
+	for i, token := range tokens {
+		// A tilde is only allowed as part of an escape sequence.
+		for j := 0; j < len(token); j++ {
+			if token[j] == '~' && (j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1')) {
+				return nil, ErrInvalidPath
+			}
+		}
+		tokens[i] = pointerUnescaper.Replace(token)
+	}
+	return tokens, nil
+}
+
+// Convert the reference tokens of a JSON Pointer into exact matchers.
+func pointerMatchers(tokens []string) []Matcher {
+	mp := make([]Matcher, 0, len(tokens))
+	for _, token := range tokens {
+		mp = append(mp, MatchExact{Value: token})
+	}
+	return mp
+}
@@ -0,0 +1,81 @@
+package traveller_test
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ezraisw/traveller"
+)
+
+func (s PathTestSuite) TestCallMustPointerPanic() {
+	s.Panics(func() {
+		traveller.MustPointer("a/b")
+	})
+}
+
+func (s PathTestSuite) TestCallPointer() {
+	cases := []pathSubTestCase{
+		{
+			in:       "",
+			expected: []traveller.Matcher{},
+		},
+		{
+			in:       "/",
+			expected: []traveller.Matcher{traveller.MatchExact{Value: ""}},
+		},
+		{
+			in:       "/a/b/0",
+			expected: []traveller.Matcher{traveller.MatchExact{Value: "a"}, traveller.MatchExact{Value: "b"}, traveller.MatchExact{Value: "0"}},
+		},
+		{
+			in:       "/a~1b/m~0n/~01/c.d/*",
+			expected: []traveller.Matcher{traveller.MatchExact{Value: "a/b"}, traveller.MatchExact{Value: "m~n"}, traveller.MatchExact{Value: "~1"}, traveller.MatchExact{Value: "c.d"}, traveller.MatchExact{Value: "*"}},
+		},
+		{
+			in:  "a/b",
+			err: traveller.ErrInvalidPath,
+		},
+		{
+			in:  "/a~2b",
+			err: traveller.ErrInvalidPath,
+		},
+		{
+			in:  "/a~",
+			err: traveller.ErrInvalidPath,
+		},
+	}
+
+	for i, c := range cases {
+		s.Run(fmt.Sprintf("Case #%d", i+1), func() {
+			mp, err := traveller.Pointer(c.in)
+			if c.err == nil {
+				s.NoError(err)
+				s.Equal(c.expected, mp)
+			} else {
+				s.ErrorIs(err, c.err)
+			}
+		})
+	}
+}
+
+func (s PathTestSuite) TestCallLocationPointer() {
+	in := map[string]any{
+		"a/b": map[int][]string{
+			3: {"x", "y"},
+		},
+	}
+
+	var pointers []string
+	cb := traveller.TravellerCallback{
+		OnFound: func(f traveller.Found) bool {
+			pointers = append(pointers, f.Location().Pointer())
+			return true
+		},
+	}
+	traveller.StartTraversal(reflect.ValueOf(in), traveller.MustPointer("/a~1b/3/1"), cb, traveller.WithParseKeys(true))
+	s.Equal([]string{"/a~1b/3/1"}, pointers)
+
+	s.Equal("", traveller.Location{}.Pointer())
+	s.Equal("/m~0n/c.d/1", traveller.Location{"m~n", "c.d", 1}.Pointer())
+	s.Equal("y", traveller.MustGet[string](in, traveller.MustPointer(traveller.Location{"a/b", 3, 1}.Pointer()), traveller.WithParseKeys(true)))
+}
@@ -0,0 +1,53 @@
+package traveller
+
+import "reflect"
+
+// Count the values matching the path.
+func Count(i any, mp []Matcher, options ...TravellerOption) int {
+	onFound := func(Found) (struct{}, bool, bool) {
+		return struct{}{}, true, true // Keep searching.
+	}
+
+	return len(findAll(reflect.ValueOf(i), mp, onFound, options))
+}
+
+// Whether any value matches the path.
+// The traversal stops on the first match.
+func Exists(i any, mp []Matcher, options ...TravellerOption) bool {
+	onFound := func(Found) (struct{}, bool, bool) {
+		return struct{}{}, true, false // Stop searching on first match.
+	}
+
+	return len(findAll(reflect.ValueOf(i), mp, onFound, options)) > 0
+}
+
+// Whether any value of type T matching the path satisfies the predicate.
+// The traversal stops on the first value that satisfies it.
+//
+// The predicate may be called concurrently when WithParallelism is set.
+func AnyMatch[T any](i any, mp []Matcher, predicate func(T) bool, options ...TravellerOption) bool {
+	onFound := func(f Found) (struct{}, bool, bool) {
+		if val, ok := interfaceAs[T](f.RV()); ok && predicate(val) {
+			return struct{}{}, true, false // Stop searching on first match.
+		}
+		return struct{}{}, false, true // Keep searching.
+	}
+
+	return len(findAll(reflect.ValueOf(i), mp, onFound, options)) > 0
+}
+
+// Whether all values of type T matching the path satisfy the predicate.
+// The traversal stops on the first value that does not satisfy it.
+// True is returned if there is no value of type T matching the path.
+//
+// The predicate may be called concurrently when WithParallelism is set.
+func AllMatch[T any](i any, mp []Matcher, predicate func(T) bool, options ...TravellerOption) bool {
+	onFound := func(f Found) (struct{}, bool, bool) {
+		if val, ok := interfaceAs[T](f.RV()); ok && !predicate(val) {
+			return struct{}{}, true, false // Stop searching on first mismatch.
+		}
+		return struct{}{}, false, true // Keep searching.
+	}
+
+	return len(findAll(reflect.ValueOf(i), mp, onFound, options)) == 0
+}
@@ -0,0 +1,75 @@
+package traveller_test
+
+import (
+	"fmt"
+
+	"github.com/ezraisw/traveller"
+	"github.com/stretchr/testify/assert"
+)
+
+type countSubTestCase[I any] struct {
+	in       I
+	mp       []traveller.Matcher
+	expected int
+	options  []traveller.TravellerOption
+}
+
+func (c countSubTestCase[I]) DoTest(assert *assert.Assertions) {
+	assert.Equal(c.expected, traveller.Count(c.in, c.mp, c.options...))
+	assert.Equal(c.expected > 0, traveller.Exists(c.in, c.mp, c.options...))
+}
+
+func (s GeneralTestSuite) TestCallCount() {
+	cases := []generalSubTestCase{
+		countSubTestCase[bulb]{in: makeBulb(), mp: traveller.P("Federation.Clean.*"), expected: 6},
+		countSubTestCase[bulb]{in: makeBulb(), mp: traveller.P("Cup.Favour"), expected: 1},
+		countSubTestCase[bulb]{in: makeBulb(), mp: traveller.P("Cup.Nonexistent"), expected: 0},
+		countSubTestCase[bulb]{
+			in:       makeBulb(),
+			mp:       traveller.P("Federation.Hate.Critic.*"),
+			expected: 5,
+			options:  []traveller.TravellerOption{traveller.WithParallelism(2)},
+		},
+		countSubTestCase[bulb]{
+			in:       makeBulb(),
+			mp:       traveller.P("**.Inheritance"),
+			expected: 1,
+			options:  []traveller.TravellerOption{traveller.WithParallelism(2)},
+		},
+	}
+
+	for i, c := range cases {
+		s.Run(fmt.Sprintf("Case #%d", i+1), func() {
+			c.DoTest(s.Assert())
+		})
+	}
+}
+
+func (s GeneralTestSuite) TestCallAnyMatch() {
+	in := makeBulb()
+
+	calls := 0
+	s.True(traveller.AnyMatch(in, traveller.P("Federation.Clean.*"), func(v int) bool {
+		calls++
+		return v > 500
+	}))
+	s.Equal(1, calls)
+
+	s.False(traveller.AnyMatch(in, traveller.P("Federation.Clean.*"), func(v int) bool { return v > 1000 }))
+	s.False(traveller.AnyMatch(in, traveller.P("Cup.Nonexistent"), func(string) bool { return true }))
+}
+
+func (s GeneralTestSuite) TestCallAllMatch() {
+	in := makeBulb()
+
+	s.True(traveller.AllMatch(in, traveller.P("Federation.Clean.*"), func(v int) bool { return v > 100 }))
+	s.True(traveller.AllMatch(in, traveller.P("Federation.Hate.*"), func(v int) bool { return v > 0 }))
+	s.True(traveller.AllMatch(in, traveller.P("Cup.Nonexistent"), func(string) bool { return false }))
+
+	calls := 0
+	s.False(traveller.AllMatch(in, traveller.P("Federation.Clean.*"), func(v int) bool {
+		calls++
+		return v == 517
+	}))
+	s.Equal(2, calls)
+}
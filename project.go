@@ -0,0 +1,162 @@
+package traveller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Build a new value containing only the values matching any of the paths.
+//
+// The matched values are copied along with their original nesting. Structs and maps
+// on the way become map[string]any, while arrays and slices become []any holding
+// only the matched elements in their original order. Maps with keys other than strings
+// become map[any]any keeping their keys, except for the root where the keys are formatted
+// as strings.
+func Project(i any, paths ...[]Matcher) map[string]any {
+	return ProjectWith(i, paths)
+}
+
+// Build a new value containing only the values matching any of the paths, using the given options.
+//
+// Behaves the same as Project. Struct fields are named by FieldName, so WithTagName
+// can be used to name them by their json tag.
+func ProjectWith(i any, paths [][]Matcher, options ...TravellerOption) map[string]any {
+	root := &projectNode{}
+	for _, mp := range paths {
+		// Whether each of the currently traversed values is an element of an array or a slice.
+		var elems []bool
+
+		cb := TravellerCallback{
+			OnTraversal: func(t Traversal) bool {
+				_, isIndex := t.Key().(int)
+				kind := t.ParentRV().Kind()
+				elems = append(elems, isIndex && (kind == reflect.Array || kind == reflect.Slice))
+				return t.then(t.RV(), func() {
+					elems = elems[:len(elems)-1]
+				})
+			},
+			OnFound: func(f Found) bool {
+				root.add(f.Location(), elems[1:], f.RV())
+				return true // Keep searching.
+			},
+		}
+
+		StartTraversal(reflect.ValueOf(i), mp, cb, options...)
+	}
+
+	switch projected := root.render().(type) {
+	case map[string]any:
+		return projected
+	case map[any]any:
+		vals := make(map[string]any, len(projected))
+		for key, val := range projected {
+			vals[formatKey(key)] = val
+		}
+		return vals
+	}
+	return make(map[string]any)
+}
+
+// Build the projection of the paths into the value that `into` points to, using the given options.
+//
+// The value is replaced by the projection of ProjectWith converted into its type, leaving
+// the values that are not matched as their zero value. Values are converted as long
+// as no information is lost, with struct fields named by FieldName on both sides.
+//
+// `into` must be a pointer to a value or it will panic.
+func ProjectInto(i any, into any, paths [][]Matcher, options ...TravellerOption) error {
+	inRv := settableRoot(into)
+
+	traveller := &Traveller{}
+	traveller.applyOptions(options)
+	newRv, ok := traveller.convertValue(ProjectWith(i, paths, options...), inRv.Type())
+	if !ok {
+		return fmt.Errorf("project into %s: %w", inRv.Type(), ErrUnassignable)
+	}
+	inRv.Set(newRv)
+	return nil
+}
+
+// A value of the projection tree.
+type projectNode struct {
+	// The copy of the matched value, which is included as a whole.
+	value    any
+	selected bool
+
+	// The children in the order they are added, keyed by their location element.
+	keys     []any
+	children map[any]*projectNode
+
+	// Whether the children are elements of an array or a slice.
+	elems bool
+}
+
+// Add the matched value of the location.
+func (n *projectNode) add(location Location, elems []bool, rv reflect.Value) {
+	for i, key := range location {
+		if n.selected {
+			return // Already included as a whole.
+		}
+		if n.children == nil {
+			n.children = make(map[any]*projectNode)
+		}
+		child, ok := n.children[key]
+		if !ok {
+			child = &projectNode{}
+			n.children[key] = child
+			n.keys = append(n.keys, key)
+		}
+		n.elems = elems[i]
+		n = child
+	}
+	if n.selected {
+		return
+	}
+
+	n.value = valueInterface(deepCopy(rv))
+	n.selected = true
+	n.keys, n.children = nil, nil
+}
+
+// Build the value of the node.
+func (n *projectNode) render() any {
+	if n.selected {
+		return n.value
+	}
+
+	if n.elems {
+		sort.SliceStable(n.keys, func(i, j int) bool {
+			return n.keys[i].(int) < n.keys[j].(int)
+		})
+		vals := make([]any, 0, len(n.keys))
+		for _, key := range n.keys {
+			vals = append(vals, n.children[key].render())
+		}
+		return vals
+	}
+
+	if !n.stringKeys() {
+		vals := make(map[any]any, len(n.keys))
+		for _, key := range n.keys {
+			vals[key] = n.children[key].render()
+		}
+		return vals
+	}
+
+	vals := make(map[string]any, len(n.keys))
+	for _, key := range n.keys {
+		vals[key.(string)] = n.children[key].render()
+	}
+	return vals
+}
+
+// Whether all keys of the children are strings, such as struct fields.
+func (n *projectNode) stringKeys() bool {
+	for _, key := range n.keys {
+		if _, ok := key.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,109 @@
+package traveller_test
+
+import "github.com/ezraisw/traveller"
+
+func (s GeneralTestSuite) TestCallProject() {
+	in := makeBulb()
+
+	s.Equal(map[string]any{
+		"Worth": []any{"9MkyQvrHMuJIQjmNgETf", "WgNTqZEG6KuSnqCocyiV", "RGnXLTPCadctoltAPnXs"},
+		"Federation": map[string]any{
+			"Hate": map[string]any{
+				"Slide": map[string]any{
+					"Swipe": map[string]any{"Deserted": map[string]any{"Plain": "St1ABpJxt6l5ktcDnXs6"}},
+				},
+			},
+		},
+		"Sunshine": 121,
+	}, traveller.Project(in, traveller.P("Worth.*"), traveller.P("Federation.Hate.Slide.Swipe.*.Plain"), traveller.P("Sunshine")))
+
+	// Values matched as a whole include everything inside them.
+	s.Equal(map[string]any{
+		"Worth": []any{"WgNTqZEG6KuSnqCocyiV"},
+		"Federation": map[string]any{
+			"Hate": map[string]any{
+				"Slide": map[string]any{"Consumption": in.Federation.Hate.Slide.Consumption},
+			},
+		},
+		"Cup": map[string]any{"Favour": "VL6foOIq436n8gevZi7K"},
+	}, traveller.ProjectWith(in, [][]traveller.Matcher{
+		traveller.P("Worth.1"),
+		traveller.P("Federation.Hate.Slide.Consumption"),
+		traveller.P("Federation.Hate.Slide.Consumption.Plain"),
+		traveller.P("Cup.Favour"),
+	}, traveller.WithParseKeys(true)))
+
+	s.Empty(traveller.Project(in, traveller.P("Missing")))
+}
+
+func (s GeneralTestSuite) TestCallProjectWith() {
+	in := makeBulb()
+
+	projected := traveller.ProjectWith(in, [][]traveller.Matcher{traveller.P("Federation.jet.party"), traveller.P("Federation.clean")}, traveller.WithTagName("json"))
+	s.Equal(map[string]any{
+		"Federation": map[string]any{
+			"jet":   map[string]any{"party": "ZPGANa8QAKvR7AFzXwCn"},
+			"clean": []int{517, 440, 168, 357, 871, 455},
+		},
+	}, projected)
+
+	// The projection does not share values with the original.
+	projected["Federation"].(map[string]any)["clean"].([]int)[0] = 0
+	s.Equal(517, in.Federation.Clean[0])
+}
+
+func (s GeneralTestSuite) TestCallProjectInto() {
+	in := makeBulb()
+
+	var summary struct {
+		Worth      []string
+		Federation struct {
+			Hate struct {
+				Critic []int64
+			}
+		}
+		Sunshine int64
+	}
+	s.Require().NoError(traveller.ProjectInto(in, &summary, [][]traveller.Matcher{traveller.P("Worth.*"), traveller.P("Federation.Hate.Critic.*"), traveller.P("Sunshine")}))
+	s.Equal(in.Worth, summary.Worth)
+	s.Equal([]int64{744, 684, 151, 243, 507}, summary.Federation.Hate.Critic)
+	s.Equal(int64(121), summary.Sunshine)
+
+	var names []string
+	s.Error(traveller.ProjectInto(in, &names, [][]traveller.Matcher{traveller.P("Worth.*")}))
+
+	// Struct fields are named the same way on both sides.
+	var tagged struct {
+		Federation struct {
+			Jet struct {
+				Party string `json:"party"`
+			} `json:"jet"`
+		}
+	}
+	s.Require().NoError(traveller.ProjectInto(in, &tagged, [][]traveller.Matcher{traveller.P("Federation.jet.party")}, traveller.WithTagName("json")))
+	s.Equal("ZPGANa8QAKvR7AFzXwCn", tagged.Federation.Jet.Party)
+}
+
+func (s GeneralTestSuite) TestCallProjectKeys() {
+	in := map[string]any{
+		"mixed": map[any]any{1: "int", "1": "string", 2: "other"},
+		"ints":  map[int]string{1: "a", 2: "b"},
+	}
+
+	// Keys other than strings are kept, so that they do not collide with the same string.
+	s.Equal(map[string]any{
+		"mixed": map[any]any{1: "int", "1": "string"},
+		"ints":  map[any]any{2: "b"},
+	}, traveller.ProjectWith(in, [][]traveller.Matcher{
+		{traveller.MatchExact{Value: "mixed"}, traveller.MatchExact{Value: 1}},
+		{traveller.MatchExact{Value: "mixed"}, traveller.MatchExact{Value: "1"}},
+		traveller.P("ints.2"),
+	}, traveller.WithParseKeys(true)))
+
+	var out map[string]map[int]string
+	s.Require().NoError(traveller.ProjectInto(in, &out, [][]traveller.Matcher{traveller.P("ints.1")}, traveller.WithParseKeys(true)))
+	s.Equal(map[string]map[int]string{"ints": {1: "a"}}, out)
+
+	// Keys of the root are formatted as strings.
+	s.Equal(map[string]any{"1": "a"}, traveller.Project(map[int]string{1: "a", 2: "b"}, []traveller.Matcher{traveller.MatchExact{Value: 1}}))
+}
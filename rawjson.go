@@ -0,0 +1,96 @@
+package traveller
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+var rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+
+// Match the decoded content of the value if it holds raw JSON to be decoded.
+// False is returned as the second value if the value is not decoded.
+func (t *Traveller) matchRawJSON(rv reflect.Value, s MatcherSegment) (keepSearching bool, ok bool) {
+	if !t.decodeRawJSON && !t.decodeBytesJSON {
+		return true, false
+	}
+	rawRv := Unbox(rv)
+	if !t.isRawJSON(rawRv) {
+		return true, false
+	}
+
+	decoded, ok := t.decodeRawJSONValue(rawRv)
+	if !ok {
+		return true, false
+	}
+
+	match := func() bool {
+		return t.mp[s.Index()].Match(reflect.ValueOf(&decoded).Elem(), s)
+	}
+	if !t.encodeRawJSON {
+		return match(), true
+	}
+
+	// The content is encoded once everything inside it is visited.
+	orig := copyJSON(decoded)
+	keepSearching = t.matchNow(match)
+
+	// Only encode modified content to keep the original formatting.
+	if !reflect.DeepEqual(orig, decoded) {
+		t.rawJSON = nil
+		if data, err := json.Marshal(decoded); err == nil {
+			assignContainer(rv, rawRv, reflect.ValueOf(data).Convert(rawRv.Type()))
+		}
+	}
+	return keepSearching, true
+}
+
+// Decode the content of the raw JSON value.
+// The content decoded last is reused when the same value is visited again, such as with MatchDescendant.
+func (t *Traveller) decodeRawJSONValue(rawRv reflect.Value) (any, bool) {
+	if c := t.rawJSON; c != nil && c.data == rawRv.Pointer() && c.len == rawRv.Len() {
+		return c.decoded, true
+	}
+
+	var decoded any
+	if err := json.Unmarshal(rawRv.Bytes(), &decoded); err != nil {
+		return nil, false
+	}
+	t.rawJSON = &rawJSONCopy{data: rawRv.Pointer(), len: rawRv.Len(), decoded: decoded}
+	return decoded, true
+}
+
+// Copy the decoded JSON content, so that it can be compared after it is modified.
+func copyJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		c := make(map[string]any, len(v))
+		for key, val := range v {
+			c[key] = copyJSON(val)
+		}
+		return c
+	case []any:
+		c := make([]any, len(v))
+		for i, val := range v {
+			c[i] = copyJSON(val)
+		}
+		return c
+	}
+	return v
+}
+
+// Whether the value holds raw JSON to be decoded according to the options.
+func (t *Traveller) isRawJSON(rv reflect.Value) bool {
+	if !rv.IsValid() {
+		return false
+	}
+	if t.decodeRawJSON && rv.Type() == rawMessageType {
+		return true
+	}
+	return t.decodeBytesJSON && rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 && json.Valid(rv.Bytes())
+}
+
+// Whether the content of the value must not be traversed by setters,
+// as modifications to it are discarded without WithEncodeRawJSON.
+func (t *Traveller) isReadOnlyRawJSON(rv reflect.Value) bool {
+	return (t.decodeRawJSON || t.decodeBytesJSON) && !t.encodeRawJSON && t.isRawJSON(Unbox(rv))
+}
@@ -0,0 +1,78 @@
+package traveller_test
+
+import (
+	"encoding/json"
+
+	"github.com/ezraisw/traveller"
+)
+
+func makeRawJSONBulb() bulb {
+	in := makeBulb()
+	in.Cup["Payload"] = json.RawMessage(`{"id": "o-1", "lines": [{"sku": "A1"}, {"sku": "B2"}]}`)
+	in.Federation.Jet.Barrel = []byte(`{"note": "fragile"}`)
+	in.Federation.Hate.Slide.Carbon = json.RawMessage(`["x", "y"]`)
+	return in
+}
+
+func (s GeneralTestSuite) TestCallGetDecodeRawJSON() {
+	in := makeRawJSONBulb()
+
+	_, ok := traveller.Get[string](in, traveller.P("Cup.Payload.id"))
+	s.False(ok)
+
+	decode := traveller.WithDecodeRawJSON(true)
+	s.Equal("o-1", traveller.MustGet[string](in, traveller.P("Cup.Payload.id"), decode))
+	s.Equal([]string{"A1", "B2"}, traveller.GetAll[string](in, traveller.P("Cup.Payload.lines.*.sku"), decode))
+	s.Equal([]string{"y"}, traveller.GetAll[string](in, traveller.P("Federation.Hate.Slide.Carbon.1"), decode, traveller.WithParseKeys(true)))
+	s.ElementsMatch([]string{
+		"ONr7QDhcZJNgiSnZByaH",
+		"VL6foOIq436n8gevZi7K",
+		"yDlqlodvPqwJFB5o8hKq",
+		"nnGbiSSEYt01kotPuVHS",
+		"o-1",
+		"A1",
+		"B2",
+	}, traveller.GetAll[string](in, traveller.P("Cup.**"), decode))
+
+	// The raw value itself is still found as is.
+	s.Equal(in.Cup["Payload"], traveller.MustGet[json.RawMessage](in, traveller.P("Cup.Payload"), decode))
+
+	_, ok = traveller.Get[string](in, traveller.P("Federation.Jet.Barrel.note"), decode)
+	s.False(ok)
+	s.Equal("fragile", traveller.MustGet[string](in, traveller.P("Federation.Jet.Barrel.note"), traveller.WithDecodeBytesJSON(true)))
+}
+
+func (s GeneralTestSuite) TestCallSetEncodeRawJSON() {
+	in := makeRawJSONBulb()
+
+	// Decoded content is not modified without encoding.
+	s.False(traveller.Set(&in, traveller.P("Cup.Payload.id"), "o-2", traveller.WithDecodeRawJSON(true)))
+	s.Equal(0, traveller.SetAll(&in, traveller.P("**.sku"), "Z9", traveller.WithDecodeRawJSON(true)))
+	s.Equal(makeRawJSONBulb(), in)
+
+	// The raw value itself can still be set.
+	s.Equal(2, traveller.SetAll(&in, traveller.P("Federation.Hate.Slide.**"), json.RawMessage(`{}`), traveller.WithDecodeRawJSON(true)))
+	s.Equal(json.RawMessage(`{}`), in.Federation.Hate.Slide.Carbon)
+	s.Equal(json.RawMessage(`{}`), in.Federation.Hate.Slide.Consumption)
+
+	in = makeRawJSONBulb()
+	options := []traveller.TravellerOption{
+		traveller.WithDecodeRawJSON(true),
+		traveller.WithDecodeBytesJSON(true),
+		traveller.WithEncodeRawJSON(true),
+		traveller.WithParseKeys(true),
+	}
+	s.True(traveller.Set(&in, traveller.P("Cup.Payload.id"), "o-2", options...))
+	s.Equal(2, traveller.SetAll(&in, traveller.P("Cup.Payload.lines.*.sku"), "Z9", options...))
+	s.True(traveller.Set(&in, traveller.P("Federation.Jet.Barrel.note"), "handle with care", options...))
+	s.True(traveller.Set(&in, traveller.P("Federation.Hate.Slide.Carbon.0"), "z", options...))
+
+	s.JSONEq(`{"id": "o-2", "lines": [{"sku": "Z9"}, {"sku": "Z9"}]}`, string(in.Cup["Payload"].(json.RawMessage)))
+	s.JSONEq(`{"note": "handle with care"}`, string(in.Federation.Jet.Barrel.([]byte)))
+	s.Equal(json.RawMessage(`["z","y"]`), in.Federation.Hate.Slide.Carbon)
+
+	// Unmodified content keeps its formatting.
+	in = makeRawJSONBulb()
+	s.False(traveller.Set(&in, traveller.P("Cup.Payload.missing.id"), "o-2", options...))
+	s.Equal(makeRawJSONBulb(), in)
+}
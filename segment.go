@@ -18,16 +18,22 @@ func (s MatcherSegment) Index() int {
 	return s.index
 }
 
+// Get the location of the value currently being matched.
+// Only available until Match returns.
+func (s MatcherSegment) Location() Location {
+	return s.traveller.location()
+}
+
 // Go to the next path segment with a new value.
 //
 // False is returned when traversal should not be continued.
 func (s MatcherSegment) Next(rv reflect.Value, parentRv reflect.Value, key any) bool {
-	return s.Traveller().Match(s.Index()+1, rv, parentRv, key)
+	return s.traveller.match(s.index+1, rv, parentRv, key)
 }
 
 // Stay on the current path segment while inspecting a new value.
 //
 // False is returned when traversal should not be continued.
 func (s MatcherSegment) Stay(rv reflect.Value, parentRv reflect.Value, key any) bool {
-	return s.Traveller().Match(s.Index(), rv, parentRv, key)
+	return s.traveller.match(s.index, rv, parentRv, key)
 }
@@ -0,0 +1,111 @@
+package traveller
+
+import "reflect"
+
+// Append the given values to all slices matching the path.
+// The grown slice is assigned back to where the slice originated.
+//
+// A slice is left untouched if any of the values is not assignable
+// to its element type.
+//
+// `in` must be a pointer to a value or it will panic.
+func Append(in any, mp []Matcher, vals ...any) int {
+	return AppendWith(in, mp, vals)
+}
+
+// Append the given values to all slices matching the path, using the given options.
+//
+// Behaves the same as Append.
+func AppendWith(in any, mp []Matcher, vals []any, options ...TravellerOption) int {
+	return insertAll(in, mp, -1, vals, options)
+}
+
+// Insert the given values at the index of all slices matching the path.
+// An index equal to the length of the slice behaves the same as Append.
+//
+// A slice is left untouched if the index is out of range or if any of the
+// values is not assignable to its element type.
+//
+// `in` must be a pointer to a value or it will panic.
+func Insert(in any, mp []Matcher, index int, vals ...any) int {
+	return InsertWith(in, mp, index, vals)
+}
+
+// Insert the given values at the index of all slices matching the path, using the given options.
+//
+// Behaves the same as Insert.
+func InsertWith(in any, mp []Matcher, index int, vals []any, options ...TravellerOption) int {
+	if index < 0 {
+		return 0
+	}
+	return insertAll(in, mp, index, vals, options)
+}
+
+// Insert the values on every matching slice.
+// A negative index means the end of the slice.
+func insertAll(in any, mp []Matcher, index int, vals []any, options []TravellerOption) int {
+	inRv := settableRoot(in)
+
+	count := 0
+	cb := TravellerCallback{
+		OnTraversal: handleInaddrVals,
+		OnFound: func(f Found) bool {
+			if insertSlice(f.RV(), index, vals) {
+				count++
+			}
+			return true // Keep searching.
+		},
+	}
+
+	StartTraversal(inRv, mp, cb, options...)
+	return count
+}
+
+// Insert the values into the slice contained in rv and assign the new slice back.
+func insertSlice(rv reflect.Value, index int, vals []any) bool {
+	sliceRv := Unbox(rv)
+	if sliceRv.Kind() != reflect.Slice {
+		return false
+	}
+
+	n := sliceRv.Len()
+	if index < 0 {
+		index = n
+	}
+	if index > n {
+		return false
+	}
+
+	elemType := sliceRv.Type().Elem()
+	valRvs := make([]reflect.Value, 0, len(vals))
+	for _, val := range vals {
+		valRv, ok := assignableValue(val, elemType)
+		if !ok {
+			return false
+		}
+		valRvs = append(valRvs, valRv)
+	}
+
+	newRv := reflect.MakeSlice(sliceRv.Type(), 0, n+len(valRvs))
+	newRv = reflect.AppendSlice(newRv, sliceRv.Slice(0, index))
+	newRv = reflect.Append(newRv, valRvs...)
+	newRv = reflect.AppendSlice(newRv, sliceRv.Slice(index, n))
+
+	return assignContainer(rv, sliceRv, newRv)
+}
+
+// Assign a new container value in place of the unboxed container of rv.
+//
+// The container itself is settable when it is behind a pointer or directly addressed.
+// Otherwise it is contained in an interface that can be reassigned.
+func assignContainer(rv, containerRv, newRv reflect.Value) bool {
+	switch {
+	case containerRv.CanSet():
+		containerRv.Set(newRv)
+	case rv.CanSet():
+		rv.Set(newRv)
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,103 @@
+package traveller_test
+
+import "github.com/ezraisw/traveller"
+
+func (s GeneralTestSuite) TestCallAppendPanic() {
+	s.Panics(func() {
+		traveller.Append(makeBulb(), traveller.P("Worth"), "value")
+	})
+}
+
+func (s GeneralTestSuite) TestCallAppend() {
+	actual := makeBulb()
+	count := traveller.Append(&actual, traveller.P("Worth"), "first", "second")
+	s.Equal(1, count)
+
+	expected := makeBulb()
+	expected.Worth = append(expected.Worth, "first", "second")
+	s.Equal(expected, actual)
+}
+
+func (s GeneralTestSuite) TestCallAppendBehindInterface() {
+	actual := makeBulb()
+	count := traveller.Append(&actual, traveller.P("Federation.Hate.Create.Fence.Knowledge.Job"), 5, "five", nil)
+	s.Equal(1, count)
+
+	expected := makeBulb()
+	job := expected.Federation.Hate.Create.Fence.Knowledge.Job.([]any)
+	expected.Federation.Hate.Create.Fence.Knowledge.Job = append(job, 5, "five", nil)
+	s.Equal(expected, actual)
+}
+
+func (s GeneralTestSuite) TestCallAppendInMap() {
+	actual := map[string]any{
+		"payload": map[string]any{
+			"events": []any{"created"},
+		},
+	}
+	count := traveller.Append(&actual, traveller.P("payload.events"), "updated")
+	s.Equal(1, count)
+	s.Equal(map[string]any{
+		"payload": map[string]any{
+			"events": []any{"created", "updated"},
+		},
+	}, actual)
+}
+
+func (s GeneralTestSuite) TestCallAppendUnassignable() {
+	actual := makeBulb()
+	count := traveller.Append(&actual, traveller.P("Worth"), "valid", 1)
+	s.Equal(0, count)
+	s.Equal(makeBulb(), actual)
+}
+
+func (s GeneralTestSuite) TestCallAppendSpread() {
+	actual := makeBulb()
+	vals := []any{"first", "second"}
+	s.Equal(1, traveller.Append(&actual, traveller.P("Worth"), vals...))
+
+	// A slice given as a single value is appended as one element.
+	s.Equal(1, traveller.Append(&actual, traveller.P("Federation.Hate.Create.Fence.Knowledge.Job"), vals))
+
+	expected := makeBulb()
+	expected.Worth = append(expected.Worth, "first", "second")
+	job := expected.Federation.Hate.Create.Fence.Knowledge.Job.([]any)
+	expected.Federation.Hate.Create.Fence.Knowledge.Job = append(job, vals)
+	s.Equal(expected, actual)
+}
+
+func (s GeneralTestSuite) TestCallAppendMultiple() {
+	actual := makeBulb()
+	count := traveller.Append(&actual, traveller.P("Federation.Hate.C*"), 1)
+	s.Equal(2, count)
+
+	expected := makeBulb()
+	expected.Federation.Hate.Couple = append(expected.Federation.Hate.Couple, 1)
+	expected.Federation.Hate.Critic = append(expected.Federation.Hate.Critic, 1)
+	s.Equal(expected, actual)
+}
+
+func (s GeneralTestSuite) TestCallInsert() {
+	actual := makeBulb()
+	count := traveller.Insert(&actual, traveller.P("Federation.Clean"), 1, 10, 20)
+	s.Equal(1, count)
+
+	expected := makeBulb()
+	expected.Federation.Clean = []int{517, 10, 20, 440, 168, 357, 871, 455}
+	s.Equal(expected, actual)
+}
+
+func (s GeneralTestSuite) TestCallInsertOutOfRange() {
+	actual := makeBulb()
+	s.Equal(0, traveller.Insert(&actual, traveller.P("Federation.Clean"), 7, 10))
+	s.Equal(0, traveller.Insert(&actual, traveller.P("Federation.Clean"), -1, 10))
+	s.Equal(makeBulb(), actual)
+}
+
+func (s GeneralTestSuite) TestCallAppendOptions() {
+	actual := map[int][]string{7: {"a"}}
+	s.Equal(0, traveller.Append(&actual, traveller.P("7"), "b"))
+	s.Equal(1, traveller.AppendWith(&actual, traveller.P("7"), []any{"b"}, traveller.WithParseKeys(true)))
+	s.Equal(1, traveller.InsertWith(&actual, traveller.P("7"), 0, []any{"c"}, traveller.WithParseKeys(true)))
+	s.Equal(map[int][]string{7: {"c", "a", "b"}}, actual)
+}
@@ -0,0 +1,79 @@
+package traveller_test
+
+import (
+	"fmt"
+
+	"github.com/ezraisw/traveller"
+)
+
+func (s GeneralTestSuite) TestCallGetAllSorted() {
+	sorted := []traveller.TravellerOption{traveller.WithSortedMaps(true)}
+	reversed := []traveller.TravellerOption{traveller.WithMapKeyLess(func(a, b any) bool {
+		return fmt.Sprint(a) > fmt.Sprint(b)
+	})}
+
+	cases := []generalSubTestCase{
+		getAllSubTestCase[bulb, string]{
+			in:       makeBulb(),
+			mp:       traveller.P("Cup.**"),
+			expected: []string{"ONr7QDhcZJNgiSnZByaH", "VL6foOIq436n8gevZi7K", "nnGbiSSEYt01kotPuVHS", "yDlqlodvPqwJFB5o8hKq"},
+			ordered:  true,
+			options:  sorted,
+		},
+		getAllSubTestCase[bulb, int]{
+			in:       makeBulb(),
+			mp:       traveller.P("Federation.Hate.Traction.*"),
+			expected: []int{5199, 1999},
+			ordered:  true,
+			options:  sorted,
+		},
+		getSubTestCase[bulb, string]{
+			in:       makeBulb(),
+			mp:       traveller.P("Federation.Decline.*"),
+			expected: "8bJD76KwNbdBMZE6L1ex",
+			options:  sorted,
+		},
+		getAllSubTestCase[map[int]string, string]{
+			in:       map[int]string{3: "c", 1: "a", 2: "b", 10: "j"},
+			mp:       traveller.P("*"),
+			expected: []string{"a", "b", "c", "j"},
+			ordered:  true,
+			options:  sorted,
+		},
+		getAllSubTestCase[bulb, string]{
+			in:       makeBulb(),
+			mp:       traveller.P("Cup.**"),
+			expected: []string{"yDlqlodvPqwJFB5o8hKq", "nnGbiSSEYt01kotPuVHS", "VL6foOIq436n8gevZi7K", "ONr7QDhcZJNgiSnZByaH"},
+			ordered:  true,
+			options:  reversed,
+		},
+		getSubTestCase[bulb, string]{
+			in:       makeBulb(),
+			mp:       traveller.P("Federation.Decline.*"),
+			expected: "43ZeSUgDdanbNBemUydH",
+			options:  reversed,
+		},
+	}
+
+	for i, c := range cases {
+		s.Run(fmt.Sprintf("Case #%d", i+1), func() {
+			// Repeat to make sure that the order does not depend on map iteration.
+			for j := 0; j < 20; j++ {
+				c.DoTest(s.Assert())
+			}
+		})
+	}
+}
+
+func (s GeneralTestSuite) TestCallDiffMapKeyLess() {
+	b := makeBulb()
+	b.Federation.Decline = map[string]string{"Victory": "edited", "Instinct": "edited"}
+
+	changes := traveller.Diff(makeBulb(), b, traveller.WithMapKeyLess(func(a, b any) bool {
+		return a.(string) > b.(string)
+	}))
+	s.Equal([]traveller.Change{
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Federation", "Decline", "Victory"}, From: "43ZeSUgDdanbNBemUydH", To: "edited"},
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Federation", "Decline", "Instinct"}, From: "8bJD76KwNbdBMZE6L1ex", To: "edited"},
+	}, changes)
+}
@@ -0,0 +1,169 @@
+package traveller
+
+import (
+	"reflect"
+	"sync"
+)
+
+// The order in which values are traversed.
+type Strategy int
+
+const (
+	// Traverse everything inside a value before moving on to its siblings.
+	DepthFirst Strategy = iota
+
+	// Traverse values level by level, starting from the ones closest to the root.
+	// Only applies to reads, as traversals with OnTraversal are always depth-first.
+	BreadthFirst
+)
+
+// A match that is deferred until it is visited.
+type pendingMatch struct {
+	index    int
+	rv       reflect.Value
+	parentRv reflect.Value
+	key      any
+	trail    *trail
+
+	// The function to call once the matches deferred before it are visited, instead of a match.
+	after func()
+}
+
+// The depth of recursive matches after which the matches are deferred to the pending matches.
+// Each pending match is visited recursively up to the same depth again, which bounds the
+// stack growth on deep values while only deferring a fraction of their matches.
+const maxRecursionDepth = 64
+
+// The length of the stacks of the traversals that are reused, which fits most values.
+const stackBufferLen = 8
+
+// The stacks of keys of the traversals that are reused, as most of them are short-lived.
+// Popped entries are not cleared, as the stacks are dropped by the garbage collector anyway.
+var stackBuffers = sync.Pool{
+	New: func() any { return new([stackBufferLen]trailEntry) },
+}
+
+// Traverse the root value using the strategy of the traveller.
+func (t *Traveller) traverse(rv reflect.Value) (keepSearching bool) {
+	// The stack is taken on the first visited child, and released by the traversal that took it.
+	ownsStack := cap(t.stack) == 0
+
+	// The trail of the root value is only created when it is needed.
+	// Depth-first traversal starts recursively, and only continues iteratively on deep values.
+	p := pendingMatch{rv: rv}
+	if t.iterating || t.forceDepthFirst > 0 || !t.deferAll() {
+		keepSearching = t.resume(&p)
+	} else {
+		keepSearching = t.iterate(&p)
+	}
+
+	if ownsStack {
+		if cap(t.stack) == stackBufferLen {
+			stackBuffers.Put((*[stackBufferLen]trailEntry)(t.stack[:stackBufferLen]))
+		}
+		t.stack = nil
+	}
+	return keepSearching
+}
+
+// Whether values are traversed breadth-first.
+// Traversals with OnTraversal are always depth-first, as values are written back after their children.
+func (t *Traveller) breadthFirst() bool {
+	return t.strategy == BreadthFirst && t.cb.OnTraversal == nil
+}
+
+// Whether every match is deferred, so that each pending match only visits a single value.
+func (t *Traveller) deferAll() bool {
+	return t.stepwise || t.breadthFirst()
+}
+
+// Whether the match being made is deferred to the pending matches instead of being visited.
+//
+// Once a match is deferred, the following matches of the same visit are deferred as well,
+// so that they are visited after it in depth-first order.
+func (t *Traveller) deferring() bool {
+	return t.iterating && t.forceDepthFirst == 0 &&
+		(t.depth >= maxRecursionDepth || len(t.pending) > t.resumed || t.deferAll())
+}
+
+// Visit the given match and everything matched from it iteratively.
+//
+// Depth-first traversal takes the last pending match, with the matches of each visit
+// reversed so that they are taken in the same order as they are matched. The functions
+// deferred by Traversal.then end up below the matches of the same visit, so that they are
+// called once everything inside the value is visited, the same as recursive traversal.
+// Breadth-first traversal takes the first pending match.
+func (t *Traveller) iterate(p *pendingMatch) (keepSearching bool) {
+	t.iterating = true
+	keepSearching = t.visitPending(p)
+	for keepSearching && len(t.pending) > 0 {
+		keepSearching = t.advance()
+	}
+	if !keepSearching {
+		t.callPendingAfters()
+	}
+	t.pending, t.iterating = t.pending[:0], false
+	return keepSearching
+}
+
+// Visit the next pending match of an iterative traversal.
+// There must be at least one pending match.
+func (t *Traveller) advance() (keepSearching bool) {
+	var p pendingMatch
+	if t.breadthFirst() {
+		p = t.pending[0]
+		t.pending = t.pending[1:]
+	} else {
+		p = t.pending[len(t.pending)-1]
+		t.pending[len(t.pending)-1] = pendingMatch{}
+		t.pending = t.pending[:len(t.pending)-1]
+	}
+	return t.visitPending(&p)
+}
+
+// Visit a pending match, or call its deferred function.
+func (t *Traveller) visitPending(p *pendingMatch) (keepSearching bool) {
+	if p.after != nil {
+		p.after()
+		return true
+	}
+
+	n := len(t.pending)
+	if !t.resume(p) {
+		return false
+	}
+	if !t.breadthFirst() {
+		reversePending(t.pending[n:])
+	}
+	return true
+}
+
+// Call the deferred functions of the values that are being visited once traversal stops,
+// starting from the deepest value.
+func (t *Traveller) callPendingAfters() {
+	for i := len(t.pending) - 1; i >= 0; i-- {
+		if after := t.pending[i].after; after != nil {
+			after()
+		}
+	}
+}
+
+// Call fn once the matches deferred so far are visited.
+// Used to write values back after everything inside them is visited.
+func (t *Traveller) deferAfter(fn func()) {
+	t.pending = append(t.pending, pendingMatch{after: fn})
+}
+
+// Call fn with everything matched inside it visited before returning, regardless of the depth.
+// Used by matchers that inspect the outcome of their matches.
+func (t *Traveller) matchNow(fn func() bool) bool {
+	t.forceDepthFirst++
+	defer func() { t.forceDepthFirst-- }()
+	return fn()
+}
+
+func reversePending(pending []pendingMatch) {
+	for i, j := 0, len(pending)-1; i < j; i, j = i+1, j-1 {
+		pending[i], pending[j] = pending[j], pending[i]
+	}
+}
@@ -0,0 +1,174 @@
+package traveller_test
+
+import (
+	"reflect"
+
+	"github.com/ezraisw/traveller"
+)
+
+func makeStrategyInput() []any {
+	return []any{
+		map[string]any{
+			"child": map[string]any{
+				"child": map[string]any{"id": 3},
+				"id":    2,
+			},
+		},
+		map[string]any{"id": 1},
+	}
+}
+
+func (s GeneralTestSuite) TestCallGetBreadthFirst() {
+	in := makeStrategyInput()
+
+	s.Equal(2, traveller.MustGet[int](in, traveller.P("**.id"), traveller.WithSortedMaps(true)))
+
+	breadthFirst := traveller.WithStrategy(traveller.BreadthFirst)
+	s.Equal(1, traveller.MustGet[int](in, traveller.P("**.id"), breadthFirst))
+	s.Equal([]int{1, 2, 3}, traveller.GetAll[int](in, traveller.P("**.id"), breadthFirst))
+	s.Equal([]int{2}, traveller.GetAll[int](in, traveller.P("0.**.id"), breadthFirst, traveller.WithParseKeys(true), traveller.WithExclude(traveller.P("**.child.child"))))
+}
+
+func (s GeneralTestSuite) TestCallSetBreadthFirst() {
+	in := makeStrategyInput()
+
+	s.Equal(3, traveller.SetAll(&in, traveller.P("**.id"), 0, traveller.WithStrategy(traveller.BreadthFirst)))
+	s.Equal([]int{0, 0, 0}, traveller.GetAll[int](in, traveller.P("**.id")))
+}
+
+func (s GeneralTestSuite) TestCallGetBreadthFirstDeep() {
+	var in any = "found"
+	for i := 0; i < 100000; i++ {
+		in = []any{in}
+	}
+
+	s.Equal("found", traveller.MustGet[string](in, traveller.P("**")))
+	s.Equal("found", traveller.MustGet[string](in, traveller.P("**"), traveller.WithStrategy(traveller.BreadthFirst)))
+}
+
+func (s GeneralTestSuite) TestCallGetAllDeep() {
+	var in any = []any{1000}
+	for i := 999; i >= 0; i-- {
+		in = []any{i, in}
+	}
+
+	expected := make([]int, 0, 1001)
+	for i := 0; i <= 1000; i++ {
+		expected = append(expected, i)
+	}
+	s.Equal(expected, traveller.GetAll[int](in, traveller.P("**")))
+
+	// The order of breadth-first traversal is the same on this shape.
+	s.Equal(expected, traveller.GetAll[int](in, traveller.P("**"), traveller.WithStrategy(traveller.BreadthFirst)))
+
+	// Values after a deep value are visited after everything inside it.
+	in = []any{1000}
+	for i := 999; i >= 0; i-- {
+		in = []any{in, i}
+	}
+	for i, j := 0, len(expected)-1; i < j; i, j = i+1, j-1 {
+		expected[i], expected[j] = expected[j], expected[i]
+	}
+	s.Equal(expected, traveller.GetAll[int](in, traveller.P("**")))
+}
+
+func (s GeneralTestSuite) TestCallUpdateAllBreadthFirst() {
+	in := makeStrategyInput()
+
+	out, count := traveller.UpdateAll(in, traveller.P("**.id"), func(id int) (any, bool, bool) {
+		return id * 10, true, true
+	}, traveller.WithStrategy(traveller.BreadthFirst))
+	s.Equal(3, count)
+	s.Equal([]int{10, 20, 30}, traveller.GetAll[int](out, traveller.P("**.id"), traveller.WithStrategy(traveller.BreadthFirst)))
+	s.Equal(makeStrategyInput(), in)
+}
+
+func (s GeneralTestSuite) TestCallLocationDeep() {
+	var in any = "found"
+	for i := 0; i < 100; i++ {
+		in = []any{0, in}
+	}
+
+	expected := make(traveller.Location, 100)
+	excluded := make([]traveller.Matcher, 70)
+	for i := range expected {
+		expected[i] = 1
+	}
+	for i := range excluded {
+		excluded[i] = traveller.MatchExact{Value: 1}
+	}
+
+	for _, strategy := range []traveller.Strategy{traveller.DepthFirst, traveller.BreadthFirst} {
+		var locations []traveller.Location
+		cb := traveller.TravellerCallback{
+			OnFound: func(f traveller.Found) bool {
+				if f.RV().Interface() == "found" {
+					locations = append(locations, f.Location())
+				}
+				return true
+			},
+		}
+		traveller.StartTraversal(reflect.ValueOf(in), traveller.P("**"), cb, traveller.WithStrategy(strategy))
+		s.Equal([]traveller.Location{expected}, locations)
+
+		s.Empty(traveller.GetAll[string](in, traveller.P("**"), traveller.WithStrategy(strategy), traveller.WithExclude(excluded)))
+	}
+}
+
+func (s GeneralTestSuite) TestCallSetAllDeep() {
+	root := map[string]any{}
+	m := root
+	for i := 0; i < 100000; i++ {
+		child := map[string]any{}
+		if i == 100 {
+			child["id"] = 1
+		}
+		m["child"] = child
+		m = child
+	}
+	m["id"] = 2
+
+	s.Equal(2, traveller.SetAll(&root, traveller.P("**.id"), 0))
+	s.Equal([]int{0, 0}, traveller.GetAll[int](root, traveller.P("**.id")))
+
+	out, count := traveller.UpdateAll(root, traveller.P("**.id"), func(id int) (any, bool, bool) {
+		return id + 1, true, true
+	})
+	s.Equal(2, count)
+	s.Equal([]int{1, 1}, traveller.GetAll[int](out, traveller.P("**.id")))
+	s.Equal([]int{0, 0}, traveller.GetAll[int](root, traveller.P("**.id")))
+
+	s.Equal(2, traveller.Delete(&root, traveller.P("**.id")))
+	s.Empty(traveller.GetAll[int](root, traveller.P("**.id")))
+}
+
+func (s GeneralTestSuite) TestCallSetAllDeepInaddressable() {
+	type node struct {
+		ID    int
+		Child any
+	}
+
+	// Structs behind interfaces are copied and written back after their children.
+	makeInput := func() any {
+		var in any = node{ID: 1000}
+		for i := 999; i >= 0; i-- {
+			in = node{ID: i, Child: in}
+		}
+		return in
+	}
+
+	in := makeInput()
+	s.Equal(1000, traveller.SetAll(&in, traveller.P("**.ID"), 7))
+	s.True(traveller.AllMatch(in, traveller.P("**.ID"), func(id int) bool { return id == 7 }))
+	s.Equal(0, traveller.MustGet[int](in, traveller.P("ID")))
+
+	// The copies are also written back when the traversal stops inside them.
+	in = makeInput()
+	s.True(traveller.SetBy(&in, traveller.P("**.ID"), func(id int) (any, bool, bool) {
+		return -1, true, id == 900
+	}))
+	ids := traveller.GetAll[int](in, traveller.P("**.ID"))
+	s.Len(ids, 1000)
+	s.Equal(-1, ids[899])
+	s.Equal(901, ids[900])
+}
@@ -1,6 +1,11 @@
 package traveller
 
-import "reflect"
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"unsafe"
+)
 
 // The traveller that is used to coordinate traversal through a value.
 type Traveller struct {
@@ -14,11 +19,77 @@ type Traveller struct {
 	ignoreStruct bool
 	ignoreMap    bool
 	ignoreArray  bool
+	tagName      string
+	parseKeys    bool
+
+	unexported         bool
+	unexportedWritable bool
+
+	sortedMaps bool
+	mapKeyLess func(a, b any) bool
+
+	strategy    Strategy
+	parallelism int
+
+	// The depth of the recursive matches since the last pending match was resumed.
+	depth int
+
+	// The trail of the value being visited. Keys visited recursively are kept on a stack
+	// on top of a trail, and are only turned into a trail when it must outlive the visit.
+	// The stack is shared by the nested visits, with the entries of the current one starting
+	// at stackStart. A nil base is the root, whose trail is created when it is needed.
+	base       *trail
+	stack      []trailEntry
+	stackStart int
+
+	// The matches that are yet to be visited, along with whether they are being processed.
+	// The number of pending matches when the last pending match was resumed tells whether
+	// a match of the current visit has been deferred.
+	pending   []pendingMatch
+	resumed   int
+	iterating bool
+
+	// Whether every match is deferred, so that values are visited one at a time.
+	stepwise bool
+
+	decodeRawJSON   bool
+	decodeBytesJSON bool
+	encodeRawJSON   bool
+
+	// The nesting of traversals that must be recursive regardless of the strategy.
+	forceDepthFirst int
+
+	// The range of the matches made from the value visited first, used by parallel traversal.
+	split *matchRange
+
+	// The last struct that is not addressable whose unexported fields were read.
+	fields *structCopy
+
+	// The last raw JSON value that was decoded, along with its content.
+	rawJSON *rawJSONCopy
+
+	// The paths to exclude from traversal, along with the locations they found.
+	exclude  [][]Matcher
+	excluded *locationTrie
+}
+
+// A struct that is not addressable, along with the addressable copy its unexported fields are read from.
+type structCopy struct {
+	rv, copyRv reflect.Value
+}
+
+// The data of a raw JSON value, along with the content decoded from it.
+type rawJSONCopy struct {
+	data    uintptr
+	len     int
+	decoded any
 }
 
 // The list of callbacks that the traveller can call on specific events.
 type TravellerCallback struct {
 	// The handler to trigger on each traversal.
+	// Everything matched from the value is visited before Traversal.Next returns,
+	// therefore values are visited recursively with this handler.
 	OnTraversal TraversalFunc
 
 	// The handler to trigger when a matching value is found.
@@ -32,8 +103,9 @@ func StartTraversal(rv reflect.Value, mp []Matcher, cb TravellerCallback, option
 		cb: cb,
 	}
 	traveller.applyOptions(options)
+	traveller.excluded = traveller.findExcluded(rv)
 
-	traveller.Match(0, rv, reflect.Value{}, nil)
+	traveller.traverse(rv)
 }
 
 // Applies the list of options to the traveller.
@@ -44,17 +116,90 @@ func (t *Traveller) applyOptions(options []TravellerOption) {
 }
 
 // Match at a specific path element with the given value.
+//
+// The location of the value is unknown to the traveller, therefore
+// locations found from this value will start from the given key.
 func (t *Traveller) Match(index int, rv, parentRv reflect.Value, key any) (keepSearching bool) {
-	next := func(newRv reflect.Value) bool {
-		if index == len(t.mp) {
-			return t.cb.OnFound == nil || t.cb.OnFound(Found{traveller: t, rv: newRv, parentRv: parentRv, key: key})
-		}
+	tr := &trail{}
+	if key != nil {
+		tr = tr.child(t.locationKey(parentRv, key))
+	}
+	return t.resume(&pendingMatch{index: index, rv: rv, parentRv: parentRv, key: key, trail: tr})
+}
 
-		segment := MatcherSegment{
-			traveller: t,
-			index:     index,
-		}
-		return t.mp[index].Match(newRv, segment)
+// Match at a specific path element with the given value obtained from the value being visited.
+// Matches are visited recursively until maxRecursionDepth, after which they are
+// deferred to the pending matches and visited iteratively.
+func (t *Traveller) match(index int, rv, parentRv reflect.Value, key any) (keepSearching bool) {
+	if r := t.split; r != nil && t.depth == 0 && t.base == r.trail && !r.next() {
+		return true
+	}
+	if t.forceDepthFirst == 0 && t.depth >= maxRecursionDepth && !t.iterating {
+		return t.iterate(&pendingMatch{index: index, rv: rv, parentRv: parentRv, key: key, trail: t.childTrail(parentRv, key)})
+	}
+	if t.deferring() {
+		t.pending = append(t.pending, pendingMatch{index: index, rv: rv, parentRv: parentRv, key: key, trail: t.childTrail(parentRv, key)})
+		return true
+	}
+
+	var excluded *locationTrie
+	if cur := t.excludedInside(); cur != nil {
+		excluded = cur.child(t.locationKey(parentRv, key))
+	}
+
+	t.depth++
+	t.push(parentRv, key, excluded)
+	keepSearching = t.visit(index, rv, parentRv, key)
+	t.stack = t.stack[:len(t.stack)-1]
+	t.depth--
+	return keepSearching
+}
+
+// Push the key of a child of the value being visited onto the stack.
+// Popped entries are reused, with only the fields that differ written, and the parent
+// is only kept when it is needed for the key of the location. Pointer writes are costly
+// while the garbage collector is running, which is often the case on large values.
+func (t *Traveller) push(parentRv reflect.Value, key any, excluded *locationTrie) {
+	if t.stack == nil {
+		t.stack = stackBuffers.Get().(*[stackBufferLen]trailEntry)[:0]
+	}
+	n := len(t.stack)
+	if n < cap(t.stack) {
+		t.stack = t.stack[:n+1]
+	} else {
+		t.stack = append(t.stack, trailEntry{})
+	}
+	entry := &t.stack[n]
+	entry.key = key
+	if entry.excluded != excluded {
+		entry.excluded = excluded
+	}
+	if entry.trail != nil {
+		entry.trail = nil
+	}
+	if t.tagName != "" {
+		entry.parentRv = parentRv
+	}
+}
+
+// Match a deferred match immediately, starting from its trail.
+func (t *Traveller) resume(p *pendingMatch) (keepSearching bool) {
+	base, stackStart, depth, resumed := t.base, t.stackStart, t.depth, t.resumed
+	t.base, t.stackStart, t.depth, t.resumed = p.trail, len(t.stack), 0, len(t.pending)
+	keepSearching = t.visit(p.index, p.rv, p.parentRv, p.key)
+	t.base, t.stackStart, t.depth, t.resumed = base, stackStart, depth, resumed
+	return keepSearching
+}
+
+// Match the value being visited immediately.
+func (t *Traveller) visit(index int, rv, parentRv reflect.Value, key any) (keepSearching bool) {
+	// Values may disappear during traversal, such as deleted map entries.
+	if !rv.IsValid() {
+		return true
+	}
+
+	if t.excludedInside().isEnd() {
+		return true
 	}
 
 	if t.cb.OnTraversal != nil {
@@ -64,11 +209,168 @@ func (t *Traveller) Match(index int, rv, parentRv reflect.Value, key any) (keepS
 			rv:        rv,
 			parentRv:  parentRv,
 			key:       key,
-			next:      next,
 		})
 	}
 
-	return next(rv)
+	return t.step(index, rv, parentRv, key)
+}
+
+// Match the value against the path segment of the index, or report it as found at the end of the path.
+func (t *Traveller) step(index int, rv, parentRv reflect.Value, key any) (keepSearching bool) {
+	if index == len(t.mp) {
+		return t.cb.OnFound == nil || t.cb.OnFound(Found{traveller: t, rv: rv, parentRv: parentRv, key: key})
+	}
+
+	segment := MatcherSegment{
+		traveller: t,
+		index:     index,
+	}
+	if t.decodeRawJSON || t.decodeBytesJSON {
+		if keepSearching, ok := t.matchRawJSON(rv, segment); ok {
+			return keepSearching
+		}
+	}
+	return t.mp[index].Match(rv, segment)
+}
+
+// Obtain the key used in a location from the key of a child obtained from the parent.
+// The location of struct fields uses the field name given by FieldName.
+func (t *Traveller) locationKey(parentRv reflect.Value, key any) any {
+	if t.tagName != "" && parentRv.Kind() == reflect.Struct {
+		if field, ok := parentRv.Type().FieldByName(key.(string)); ok {
+			if name, ok := t.FieldName(field); ok {
+				return name
+			}
+		}
+	}
+	return locationKey(key)
+}
+
+// Create the trail of a child obtained from the value being visited using the given key.
+func (t *Traveller) childTrail(parentRv reflect.Value, key any) *trail {
+	return t.trail().child(t.locationKey(parentRv, key))
+}
+
+// Create the trail of the root value, holding the excluded locations.
+func (t *Traveller) root() *trail {
+	return &trail{excluded: t.excluded}
+}
+
+// Obtain the trail of the value being visited, turning the keys on the stack into trails.
+// The trails are kept on the stack so that they are only created once.
+func (t *Traveller) trail() *trail {
+	if t.base == nil {
+		t.base = t.root()
+	}
+	tr := t.base
+	for i := t.stackStart; i < len(t.stack); i++ {
+		entry := &t.stack[i]
+		if entry.trail == nil {
+			entry.trail = &trail{
+				parent:   tr,
+				key:      t.locationKey(entry.parentRv, entry.key),
+				depth:    tr.depth + 1,
+				excluded: entry.excluded,
+			}
+		}
+		tr = entry.trail
+	}
+	return tr
+}
+
+// Obtain the location of the value being visited.
+func (t *Traveller) location() Location {
+	var depth int
+	if t.base != nil {
+		depth = t.base.depth
+	}
+
+	stack := t.stack[t.stackStart:]
+	l := make(Location, depth+len(stack))
+	if t.base != nil {
+		t.base.fill(l)
+	}
+	for i, entry := range stack {
+		l[depth+i] = t.locationKey(entry.parentRv, entry.key)
+	}
+	return l
+}
+
+// Get the excluded locations inside the value being visited.
+func (t *Traveller) excludedInside() *locationTrie {
+	if n := len(t.stack); n > t.stackStart {
+		return t.stack[n-1].excluded
+	}
+	if t.base == nil {
+		return t.excluded
+	}
+	return t.base.excluded
+}
+
+// Find the locations of the excluded paths starting from the given values.
+//
+// Shared values visited again inside of themselves at the same segment of a path are cyclic.
+// They are not visited again, instead their location is linked to the first one.
+func (t *Traveller) findExcluded(rvs ...reflect.Value) *locationTrie {
+	if len(t.exclude) == 0 {
+		return nil
+	}
+
+	excluded := &locationTrie{}
+	var links [][2]Location
+	for _, mp := range t.exclude {
+		visiting := make(map[excludeRef]Location)
+		sub := t.sub(mp, TravellerCallback{
+			OnTraversal: func(tr Traversal) bool {
+				ref, ok := cloneRefOf(tr.RV())
+				if !ok {
+					return tr.Next(tr.RV())
+				}
+				key := excludeRef{ref: ref, index: tr.Index()}
+				if location, ok := visiting[key]; ok {
+					links = append(links, [2]Location{tr.Location(), location})
+					return true
+				}
+				visiting[key] = tr.Location()
+				return tr.then(tr.RV(), func() {
+					delete(visiting, key)
+				})
+			},
+			OnFound: func(f Found) bool {
+				excluded.add(f.Location())
+				return true // Keep searching.
+			},
+		})
+		for _, rv := range rvs {
+			sub.traverse(rv)
+		}
+	}
+	for _, link := range links {
+		excluded.link(link[0], link[1])
+	}
+	return excluded
+}
+
+// The identity of a shared value visited at a segment of a path.
+type excludeRef struct {
+	ref   cloneRef
+	index int
+}
+
+func (t *Traveller) sub(mp []Matcher, cb TravellerCallback) *Traveller {
+	sub := *t
+	sub.mp = mp
+	sub.cb = cb
+	sub.exclude, sub.excluded = nil, nil
+	sub.depth, sub.pending, sub.iterating, sub.resumed, sub.stepwise, sub.forceDepthFirst = 0, nil, false, 0, false, 0
+	sub.base, sub.stack, sub.stackStart = nil, nil, 0
+	sub.split, sub.rawJSON = nil, nil
+	return &sub
+}
+
+// Traverse the given value using the path with the same options.
+func (t *Traveller) Query(rv reflect.Value, mp []Matcher, onFound FoundFunc) {
+	t.sub(mp, TravellerCallback{OnFound: onFound}).traverse(rv)
 }
 
 // Get the length of the path.
@@ -95,3 +397,156 @@ func (t Traveller) IgnoreMap() bool {
 func (t Traveller) IgnoreArray() bool {
 	return t.ignoreArray
 }
+
+// Whether to decode json.RawMessage values on traversal.
+func (t Traveller) DecodeRawJSON() bool {
+	return t.decodeRawJSON
+}
+
+// Whether to decode []byte values holding valid JSON on traversal.
+func (t Traveller) DecodeBytesJSON() bool {
+	return t.decodeBytesJSON
+}
+
+// Whether to encode modified JSON content back into its original value.
+func (t Traveller) EncodeRawJSON() bool {
+	return t.encodeRawJSON
+}
+
+// Get the name of the tag used to name struct fields.
+func (t Traveller) TagName() string {
+	return t.tagName
+}
+
+// Get whether unexported struct fields are traversed.
+func (t Traveller) Unexported() bool {
+	return t.unexported
+}
+
+// Get whether unexported struct fields can be set.
+func (t Traveller) UnexportedWritable() bool {
+	return t.unexportedWritable
+}
+
+// Get whether string keys are parsed into indexes and map keys.
+func (t Traveller) ParseKeys() bool {
+	return t.parseKeys
+}
+
+// Get the order in which values are traversed.
+func (t Traveller) Strategy() Strategy {
+	return t.strategy
+}
+
+// Get the number of goroutines used by read-only traversals.
+func (t Traveller) Parallelism() int {
+	return t.parallelism
+}
+
+// Get whether map keys are traversed in sorted order.
+func (t Traveller) SortedMaps() bool {
+	return t.sortedMaps
+}
+
+// Get the name of the struct field used for matching.
+//
+// The name is obtained from the tag given by WithTagName, falling back to the field name.
+// False is returned if the field should not be traversed, which are unexported fields
+// unless WithUnexported is set, and fields with the tag value of "-".
+func (t Traveller) FieldName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() && !t.unexported {
+		return "", false
+	}
+	if t.tagName == "" {
+		return field.Name, true
+	}
+
+	tag := field.Tag.Get(t.tagName)
+	if tag == "-" {
+		return "", false
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, true
+	}
+	return field.Name, true
+}
+
+// Get the i-th field of the struct value.
+//
+// Unexported fields are made readable when WithUnexported is set, in which case
+// the returned value is a read-only copy. The field itself is returned when
+// WithUnexportedWritable is set and the struct is addressable.
+func (t *Traveller) Field(rv reflect.Value, i int) reflect.Value {
+	fieldRv := rv.Field(i)
+	if fieldRv.CanInterface() || !t.unexported {
+		return fieldRv
+	}
+
+	// Fields of a struct that is not addressable are read from an addressable copy,
+	// which is made once for all of its fields.
+	if !rv.CanAddr() {
+		if t.fields == nil || rv != t.fields.rv {
+			copyRv := reflect.New(rv.Type()).Elem()
+			copyRv.Set(rv)
+			t.fields = &structCopy{rv: rv, copyRv: copyRv}
+		}
+		return readOnly(accessField(t.fields.copyRv.Field(i)))
+	}
+
+	accessRv := accessField(fieldRv)
+	if t.unexportedWritable {
+		return accessRv
+	}
+	return readOnly(accessRv)
+}
+
+// Access an addressable unexported field.
+func accessField(fieldRv reflect.Value) reflect.Value {
+	return reflect.NewAt(fieldRv.Type(), unsafe.Pointer(fieldRv.UnsafeAddr())).Elem()
+}
+
+// Copy the value into a value of the same type that cannot be set.
+// Converting an addressable value copies it without the address.
+func readOnly(rv reflect.Value) reflect.Value {
+	return rv.Convert(rv.Type())
+}
+
+// Get the keys of the map value.
+// The keys are sorted when WithSortedMaps or WithMapKeyLess is set.
+func (t Traveller) MapKeys(rv reflect.Value) []reflect.Value {
+	keys := rv.MapKeys()
+	if t.sortedMaps {
+		t.sortMapKeys(keys)
+	}
+	return keys
+}
+
+// Call fn on each entry of the map value, in the order of MapKeys.
+// Unsorted maps are ranged over directly, without collecting their keys.
+func (t Traveller) rangeMap(rv reflect.Value, fn func(keyRv, valueRv reflect.Value) bool) bool {
+	if !t.sortedMaps {
+		for it := rv.MapRange(); it.Next(); {
+			if !fn(it.Key(), it.Value()) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, keyRv := range t.MapKeys(rv) {
+		if !fn(keyRv, rv.MapIndex(keyRv)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Sort map keys using the comparator given by WithMapKeyLess, or in their natural order.
+func (t Traveller) sortMapKeys(keys []reflect.Value) {
+	if t.mapKeyLess == nil {
+		sortKeys(keys)
+		return
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return t.mapKeyLess(keys[i].Interface(), keys[j].Interface())
+	})
+}
@@ -0,0 +1,309 @@
+package traveller
+
+import (
+	"container/list"
+	"reflect"
+	"sync"
+)
+
+// A custom container that can be traversed.
+//
+// The built-in matchers consult Traversable before falling back to reflection,
+// allowing types such as ordered maps and lazy-loaded proxies to be traversed.
+// The container is treated like a map of its keys. Keys obtained from a string path
+// are strings, therefore the container is responsible for converting them if needed.
+type Traversable interface {
+	// Get the keys of the container in order of traversal.
+	TravellerKeys() []any
+
+	// Get the value of the key.
+	// False is returned if the key does not exist.
+	TravellerGet(key any) (any, bool)
+
+	// Set the value of the key.
+	// False is returned if the value cannot be set.
+	TravellerSet(key, val any) bool
+}
+
+// A custom container that can also remove its keys.
+//
+// Delete, and the functions built on it, only remove the keys of a Traversable that implements it.
+type TraversableDeleter interface {
+	Traversable
+
+	// Remove the key from the container.
+	// False is returned if the key does not exist or cannot be removed.
+	//
+	// The keys obtained from TravellerKeys before the removal are still used afterwards,
+	// so they should keep referring to the same values.
+	TravellerDelete(key any) bool
+}
+
+var (
+	traversableType = reflect.TypeOf((*Traversable)(nil)).Elem()
+	syncMapPtrType  = reflect.TypeOf((*sync.Map)(nil))
+	listPtrType     = reflect.TypeOf((*list.List)(nil))
+)
+
+// Whether the type is one of the built-in containers adapted into a Traversable, or a pointer to one.
+func isContainerType(typ reflect.Type) bool {
+	switch typ {
+	case syncMapPtrType, listPtrType, syncMapPtrType.Elem(), listPtrType.Elem():
+		return true
+	}
+	return false
+}
+
+// Obtain the Traversable behind the value, unwrapping interfaces and pointers.
+// The value implementing Traversable is returned along with it.
+func asTraversable(rv reflect.Value) (Traversable, reflect.Value, bool) {
+	// Nil interfaces and pointers have invalid elements.
+	for rv.IsValid() {
+		kind := rv.Kind()
+		if kind == reflect.Interface {
+			rv = rv.Elem()
+			continue
+		}
+		k := traversableTypeOf(rv.Type())
+		if k.self && (kind != reflect.Ptr || !rv.IsNil()) {
+			if tr, ok := traversableOf(rv); ok {
+				return tr, rv, true
+			}
+		}
+		if k.ptr && rv.CanAddr() {
+			if tr, ok := traversableOf(rv.Addr()); ok {
+				return tr, rv.Addr(), true
+			}
+		}
+		if kind != reflect.Ptr {
+			break
+		}
+		rv = rv.Elem()
+	}
+	return nil, reflect.Value{}, false
+}
+
+// Obtain the Traversable behind the value for reading.
+// Values that are only Traversable through their pointer but are not addressable are read
+// from an addressable copy, which shares the same content.
+func readTraversable(rv reflect.Value) (Traversable, bool) {
+	if tr, _, ok := asTraversable(rv); ok {
+		return tr, true
+	}
+	rv = Unbox(rv)
+	if !rv.IsValid() || rv.CanAddr() || !rv.CanInterface() || !traversableTypeOf(rv.Type()).ptr {
+		return nil, false
+	}
+	copyRv := reflect.New(rv.Type()).Elem()
+	copyRv.Set(rv)
+	tr, _, ok := asTraversable(copyRv)
+	return tr, ok
+}
+
+// Obtain the Traversable of the value itself.
+// Built-in containers such as *sync.Map and *list.List are adapted into a Traversable.
+func traversableOf(rv reflect.Value) (Traversable, bool) {
+	if !rv.IsValid() || rv.Kind() == reflect.Interface || !traversableTypeOf(rv.Type()).self || !rv.CanInterface() {
+		return nil, false
+	}
+	switch v := rv.Interface().(type) {
+	case Traversable:
+		return v, true
+	case *sync.Map:
+		return syncMapTraversable{m: v}, v != nil
+	case *list.List:
+		return &listTraversable{l: v}, v != nil
+	}
+	return nil, false
+}
+
+// Whether values of a type and pointers to them are Traversable or adapted into one.
+type traversableKind struct {
+	self, ptr bool
+}
+
+// Cache of traversableKind by reflect.Type, as most types are checked on every visit.
+var traversableKinds sync.Map
+
+func traversableTypeOf(typ reflect.Type) traversableKind {
+	// Interfaces are unwrapped instead. Unnamed and predeclared types have no methods,
+	// except for the ones promoted into structs and the ones of the elements of pointers.
+	switch typ.Kind() {
+	case reflect.Interface:
+		return traversableKind{}
+	case reflect.Struct, reflect.Ptr:
+	default:
+		if typ.PkgPath() == "" {
+			return traversableKind{}
+		}
+	}
+	if k, ok := traversableKinds.Load(typ); ok {
+		return k.(traversableKind)
+	}
+	k := traversableKind{self: isTraversableType(typ)}
+	if typ.Kind() != reflect.Ptr {
+		k.ptr = isTraversableType(reflect.PtrTo(typ))
+	}
+	traversableKinds.Store(typ, k)
+	return k
+}
+
+// Whether values of the type are Traversable or adapted into one.
+func isTraversableType(typ reflect.Type) bool {
+	return typ == syncMapPtrType || typ == listPtrType || typ.Implements(traversableType)
+}
+
+// Whether the value is a Traversable that is used as a parent.
+func isTraversable(rv reflect.Value) bool {
+	_, ok := traversableOf(rv)
+	return ok
+}
+
+// Get the child of the key as a settable value.
+func traversableChild(tr Traversable, key any) (reflect.Value, bool) {
+	val, ok := tr.TravellerGet(key)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	return reflect.ValueOf(&val).Elem(), true
+}
+
+// Obtain the key of the container that is equal to the given key, such as the index of
+// a *list.List from a string. Used so that locations have the same key regardless of the path.
+func traversableKey(tr Traversable, key any) any {
+	if lt, ok := tr.(*listTraversable); ok {
+		if i, ok := indexKey(key, true); ok && i < len(lt.elements()) {
+			return i
+		}
+	}
+	return key
+}
+
+// Shallow copy the values of the built-in container src into the empty container dst of the same type.
+func copyContainer(dst, src any) {
+	switch src := src.(type) {
+	case *sync.Map:
+		dst := dst.(*sync.Map)
+		src.Range(func(key, val any) bool {
+			dst.Store(key, val)
+			return true
+		})
+	case *list.List:
+		dst := dst.(*list.List).Init()
+		for e := src.Front(); e != nil; e = e.Next() {
+			dst.PushBack(e.Value)
+		}
+	}
+}
+
+// Call fn on each child of the Traversable.
+func forEachTraversable(tr Traversable, fn func(childRv reflect.Value, key any) bool) bool {
+	for _, key := range tr.TravellerKeys() {
+		childRv, ok := traversableChild(tr, key)
+		if !ok {
+			continue
+		}
+		if !fn(childRv, key) {
+			return false
+		}
+	}
+	return true
+}
+
+// The Traversable of *sync.Map, with keys sorted by their value.
+type syncMapTraversable struct {
+	m *sync.Map
+}
+
+func (t syncMapTraversable) TravellerKeys() []any {
+	var keyRvs []reflect.Value
+	t.m.Range(func(key, _ any) bool {
+		keyRvs = append(keyRvs, reflect.ValueOf(key))
+		return true
+	})
+	sortKeys(keyRvs)
+
+	keys := make([]any, 0, len(keyRvs))
+	for _, keyRv := range keyRvs {
+		keys = append(keys, valueInterface(keyRv))
+	}
+	return keys
+}
+
+func (t syncMapTraversable) TravellerGet(key any) (any, bool) {
+	return t.m.Load(key)
+}
+
+func (t syncMapTraversable) TravellerSet(key, val any) bool {
+	t.m.Store(key, val)
+	return true
+}
+
+func (t syncMapTraversable) TravellerDelete(key any) bool {
+	_, ok := t.m.LoadAndDelete(key)
+	return ok
+}
+
+// The Traversable of *list.List, with the element indexes as keys.
+// The elements are collected on first use, and removed elements keep their index empty
+// so that the indexes of the other elements do not change.
+type listTraversable struct {
+	l     *list.List
+	elems []*list.Element
+}
+
+func (t *listTraversable) elements() []*list.Element {
+	if t.elems == nil && t.l != nil {
+		t.elems = make([]*list.Element, 0, t.l.Len())
+		for e := t.l.Front(); e != nil; e = e.Next() {
+			t.elems = append(t.elems, e)
+		}
+	}
+	return t.elems
+}
+
+// Get the element of the key, nil if it does not exist.
+func (t *listTraversable) element(key any) *list.Element {
+	i, ok := indexKey(key, true)
+	if !ok || i >= len(t.elements()) {
+		return nil
+	}
+	return t.elements()[i]
+}
+
+func (t *listTraversable) TravellerKeys() []any {
+	keys := make([]any, 0, len(t.elements()))
+	for i, e := range t.elements() {
+		if e != nil {
+			keys = append(keys, i)
+		}
+	}
+	return keys
+}
+
+func (t *listTraversable) TravellerGet(key any) (any, bool) {
+	e := t.element(key)
+	if e == nil {
+		return nil, false
+	}
+	return e.Value, true
+}
+
+func (t *listTraversable) TravellerSet(key, val any) bool {
+	e := t.element(key)
+	if e == nil {
+		return false
+	}
+	e.Value = val
+	return true
+}
+
+func (t *listTraversable) TravellerDelete(key any) bool {
+	i, ok := indexKey(key, true)
+	if !ok || i >= len(t.elements()) || t.elems[i] == nil {
+		return false
+	}
+	t.l.Remove(t.elems[i])
+	t.elems[i] = nil
+	return true
+}
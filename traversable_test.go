@@ -0,0 +1,107 @@
+package traveller_test
+
+import "github.com/ezraisw/traveller"
+
+type orderedMap struct {
+	keys   []string
+	values map[string]any
+}
+
+func newOrderedMap(kvs ...any) *orderedMap {
+	m := &orderedMap{values: make(map[string]any)}
+	for i := 0; i < len(kvs); i += 2 {
+		m.TravellerSet(kvs[i], kvs[i+1])
+	}
+	return m
+}
+
+func (m *orderedMap) TravellerKeys() []any {
+	keys := make([]any, 0, len(m.keys))
+	for _, key := range m.keys {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (m *orderedMap) TravellerGet(key any) (any, bool) {
+	k, ok := key.(string)
+	if !ok {
+		return nil, false
+	}
+	val, ok := m.values[k]
+	return val, ok
+}
+
+func (m *orderedMap) TravellerSet(key, val any) bool {
+	k, ok := key.(string)
+	if !ok {
+		return false
+	}
+	if _, ok := m.values[k]; !ok {
+		m.keys = append(m.keys, k)
+	}
+	m.values[k] = val
+	return true
+}
+
+func makeTraversableBulb() bulb {
+	in := makeBulb()
+	in.Cup["Settings"] = newOrderedMap(
+		"zeta", "last",
+		"alpha", offroad{Surprise: []int{1, 2}, Bake: "first", Deprive: "second"},
+		"nested", newOrderedMap("key", "value"),
+	)
+	in.Federation.Jet.Barrel = newOrderedMap("x", 1, "y", 2)
+	return in
+}
+
+func (s GeneralTestSuite) TestCallGetTraversable() {
+	in := makeTraversableBulb()
+
+	s.Equal("last", traveller.MustGet[string](in, traveller.P("Cup.Settings.zeta")))
+	s.Equal("first", traveller.MustGet[string](in, traveller.P("Cup.Settings.alpha.Bake")))
+	s.Equal("value", traveller.MustGet[string](in, traveller.P("Cup.Settings.nested.key")))
+	s.Equal([]int{1, 2}, traveller.GetAll[int](in, traveller.P("Federation.Jet.Barrel.*")))
+	s.Equal([]string{"last", "first", "second", "value"}, traveller.GetAll[string](in, traveller.P("Cup.Settings.**")))
+	s.Equal([]string{"value"}, traveller.GetAll[string](in, traveller.MustJSONPath("$..key")))
+
+	_, ok := traveller.Get[string](in, traveller.P("Cup.Settings.missing"))
+	s.False(ok)
+}
+
+func (s GeneralTestSuite) TestCallSetTraversable() {
+	in := makeTraversableBulb()
+	settings := in.Cup["Settings"].(*orderedMap)
+
+	s.True(traveller.Set(&in, traveller.P("Cup.Settings.zeta"), "changed"))
+	s.True(traveller.Set(&in, traveller.P("Cup.Settings.alpha.Bake"), "renamed"))
+	s.Equal(2, traveller.SetAll(&in, traveller.P("Cup.Settings.alpha.Surprise.*"), 0))
+	s.Equal(2, traveller.SetAll(&in, traveller.P("Federation.Jet.Barrel.*"), 0))
+
+	s.Equal("changed", settings.values["zeta"])
+	s.Equal(offroad{Surprise: []int{0, 0}, Bake: "renamed", Deprive: "second"}, settings.values["alpha"])
+	s.Equal(map[string]any{"x": 0, "y": 0}, in.Federation.Jet.Barrel.(*orderedMap).values)
+	s.Equal([]string{"zeta", "alpha", "nested"}, settings.keys)
+}
+
+func (s GeneralTestSuite) TestCallDeleteTraversable() {
+	in := makeTraversableBulb()
+
+	// Keys are only removed from containers implementing TraversableDeleter.
+	s.Equal(0, traveller.Delete(&in, traveller.P("Cup.Settings.zeta")))
+	s.Equal(2, traveller.Delete(&in, traveller.P("Cup.Settings.alpha.Surprise.*")))
+	s.Equal(makeTraversableBulb().Cup["Settings"].(*orderedMap).keys, in.Cup["Settings"].(*orderedMap).keys)
+	s.Empty(traveller.GetAll[int](in, traveller.P("Cup.Settings.alpha.Surprise.*")))
+}
+
+func (s GeneralTestSuite) TestCallUpdateAllTraversable() {
+	in := makeTraversableBulb()
+
+	// Values inside custom Traversable containers are not modified, as they cannot be copied.
+	out, count := traveller.UpdateAll(in, traveller.P("Cup.Settings.zeta"), func(any) (any, bool, bool) {
+		return "changed", true, true
+	})
+	s.Equal(0, count)
+	s.Equal("last", in.Cup["Settings"].(*orderedMap).values["zeta"])
+	s.Same(in.Cup["Settings"], out.Cup["Settings"])
+}
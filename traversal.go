@@ -9,7 +9,6 @@ type Traversal struct {
 	rv        reflect.Value
 	parentRv  reflect.Value
 	key       any
-	next      func(reflect.Value) (keepSearching bool)
 }
 
 // Get the traveller instance.
@@ -44,9 +43,47 @@ func (t Traversal) Key() any {
 	return t.key
 }
 
+// Get the location of the currently traversed value from the root.
+// Only available until the callback returns.
+func (t Traversal) Location() Location {
+	return t.traveller.location()
+}
+
 // Continue to the next traversal. Returns true if traversal should continue.
+//
+// Everything matched from the value is visited before Next returns.
 func (t Traversal) Next(rv reflect.Value) bool {
-	return t.next(rv)
+	t.traveller.forceDepthFirst++
+	keepSearching := t.traveller.step(t.index, rv, t.parentRv, t.key)
+	t.traveller.forceDepthFirst--
+	return keepSearching
+}
+
+// Continue to the next traversal, calling after once everything matched from the value is visited.
+//
+// Unlike Next, deep values are visited iteratively once the handler returns, in which case
+// after is called later. Handlers of modifying traversals use this to write values back
+// without growing the stack.
+func (t Traversal) then(rv reflect.Value, after func()) bool {
+	keepSearching, deferred := t.nextDeferred(rv)
+	switch {
+	case after == nil:
+	case deferred:
+		t.traveller.deferAfter(after)
+	default:
+		after()
+	}
+	return keepSearching
+}
+
+// Continue to the next traversal, reporting whether some of the values matched from the value
+// are deferred. In that case, the functions to call once they are visited are given to deferAfter.
+//
+// Handlers use this over then to only allocate the function when it is deferred.
+func (t Traversal) nextDeferred(rv reflect.Value) (keepSearching, deferred bool) {
+	n := len(t.traveller.pending)
+	keepSearching = t.traveller.step(t.index, rv, t.parentRv, t.key)
+	return keepSearching, len(t.traveller.pending) > n
 }
 
 // The callback on each traversal.
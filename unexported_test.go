@@ -0,0 +1,77 @@
+package traveller_test
+
+import "github.com/ezraisw/traveller"
+
+func (s GeneralTestSuite) TestCallGetUnexported() {
+	in := makeBulb()
+
+	_, ok := traveller.Get[int](in, traveller.P("Federation.Jet.hidden.Peace"))
+	s.False(ok)
+
+	unexported := traveller.WithUnexported(true)
+	s.Equal(3, traveller.MustGet[int](in, traveller.P("Federation.Jet.hidden.Peace"), unexported))
+	s.Equal(3, traveller.MustGet[int](&in, traveller.P("Federation.Jet.hidden.Peace"), unexported))
+	s.Equal("Pe6JhS3uDf9GaY0oRi5x", traveller.MustGet[string](in, traveller.P("Federation.Jet.lining.1"), unexported, traveller.WithParseKeys(true)))
+	s.Equal([]string{
+		"ZPGANa8QAKvR7AFzXwCn",
+		"zJwMx0qTQaYWqKsOABNf",
+		"EmzONvxGZb0hc2MQK9Bu",
+		"Qm8RzT1vKc0pXy4LwN2e",
+		"Hs5dGj7UaE3bVo9iFt6q",
+		"Zr4nWq8LpX2cVb7MkT1y",
+		"Pe6JhS3uDf9GaY0oRi5x",
+	}, traveller.GetAll[string](in, traveller.P("Federation.Jet.**"), unexported))
+}
+
+func (s GeneralTestSuite) TestCallSetUnexported() {
+	in := makeBulb()
+
+	s.Equal(0, traveller.SetAll(&in, traveller.P("Federation.Jet.hidden.Peace"), 10, traveller.WithUnexported(true)))
+	s.Equal(0, traveller.SetAll(&in, traveller.P("Federation.Jet.hidden"), swipe{}, traveller.WithUnexported(true)))
+	s.Equal(makeBulb(), in)
+
+	writable := traveller.WithUnexportedWritable(true)
+	s.Equal(2, traveller.SetAll(&in, traveller.P("Federation.Jet.hidden.*"), "edited", writable))
+	s.Equal(swipe{Plain: "edited", Meaning: "edited", Peace: 3}, in.Federation.Jet.hidden)
+
+	s.Equal(1, traveller.SetAll(&in, traveller.P("Federation.Jet.lining.0"), "z", writable, traveller.WithParseKeys(true)))
+	s.Equal([]string{"z", "Pe6JhS3uDf9GaY0oRi5x"}, in.Federation.Jet.lining)
+
+	s.Equal(1, traveller.SetAll(&in, traveller.P("Federation.Jet.hidden"), swipe{}, writable))
+	s.Equal(swipe{}, in.Federation.Jet.hidden)
+}
+
+func (s GeneralTestSuite) TestCallDiffUnexported() {
+	a := makeBulb()
+	b := makeBulb()
+	b.Federation.Jet.hidden.Peace = 4
+
+	s.Empty(traveller.Diff(a, b))
+	s.Equal([]traveller.Change{
+		{Type: traveller.ChangeModified, Location: traveller.Location{"Federation", "Jet", "hidden", "Peace"}, From: 3, To: 4},
+	}, traveller.Diff(a, b, traveller.WithUnexported(true)))
+}
+
+func (s GeneralTestSuite) TestCallUpdateAllUnexported() {
+	in := makeBulb()
+	increment := func(v int) (any, bool, bool) {
+		return v + 1, true, true
+	}
+
+	out, count := traveller.UpdateAll(in, traveller.P("Federation.Jet.hidden.Peace"), increment, traveller.WithUnexported(true))
+	s.Equal(0, count)
+	s.Equal(in, out)
+
+	out = traveller.With(in, traveller.P("Federation.Jet.hidden"), swipe{}, traveller.WithUnexported(true))
+	s.Equal(in, out)
+
+	writable := traveller.WithUnexportedWritable(true)
+	out, count = traveller.UpdateAll(in, traveller.P("Federation.Jet.hidden.Peace"), increment, writable)
+	s.Equal(1, count)
+	s.Equal(4, out.Federation.Jet.hidden.Peace)
+	s.Equal(makeBulb(), in)
+
+	out = traveller.With(in, traveller.P("Federation.Jet.lining.0"), "z", writable, traveller.WithParseKeys(true))
+	s.Equal([]string{"z", "Pe6JhS3uDf9GaY0oRi5x"}, out.Federation.Jet.lining)
+	s.Equal(makeBulb(), in)
+}
@@ -0,0 +1,183 @@
+package traveller
+
+import "reflect"
+
+// Set all values matching the path using the given value, creating
+// the values that do not exist yet.
+//
+// Exact path segments (MatchExact) will create missing map entries,
+// grow slices, and allocate nil pointers, maps, and interfaces on the way.
+// Slices are not grown by more than 65536 elements at once.
+// Anything created is reverted if the value ends up not being assigned.
+//
+// `in` must be a pointer to a value or it will panic.
+func Upsert(in any, mp []Matcher, val any, options ...TravellerOption) int {
+	return UpsertBy(in, mp, func(any) (any, bool, bool) { return val, true, true }, options...)
+}
+
+// Set all values using a function matching the path and type, creating
+// the values that do not exist yet.
+//
+// The `setter` behaves the same as the one in SetAllBy.
+// Missing values are given to the `setter` as their zero value.
+//
+// `in` must be a pointer to a value or it will panic.
+func UpsertBy[T any](in any, mp []Matcher, setter SetterFunc[T], options ...TravellerOption) int {
+	count := 0
+	upsertBy(settableRoot(in), mp, setter, &count, options)
+	return count
+}
+
+// Upsert all values matching the path and type starting from the given settable value.
+// Every successful assignment increments count.
+func upsertBy[T any](inRv reflect.Value, mp []Matcher, setter SetterFunc[T], count *int, options []TravellerOption) {
+	upsertMp := make([]Matcher, len(mp))
+	for i, m := range mp {
+		if exact, ok := m.(MatchExact); ok {
+			m = matchUpsert{MatchExact: exact, count: count}
+		}
+		upsertMp[i] = m
+	}
+	setAllBy(inRv, upsertMp, setter, count, options)
+}
+
+// The maximum number of elements a slice can grow by to upsert an index beyond its length.
+// Prevents an index of the path from allocating an arbitrary amount of memory.
+const maxSliceGrowth = 1 << 16
+
+// Exact match that creates the value if it does not exist.
+//
+// The given value must be settable, which is guaranteed when
+// traversed through handleInaddrVals.
+type matchUpsert struct {
+	MatchExact
+
+	// The assignment count of the operation.
+	// Used to determine whether created values should be reverted.
+	count *int
+}
+
+// Compile-time implementation check.
+var _ Matcher = (*matchUpsert)(nil)
+
+func (m matchUpsert) Match(rv reflect.Value, s MatcherSegment) bool {
+	// The allocations are reverted depending on the assignments made inside the value.
+	return s.Traveller().matchNow(func() bool {
+		return m.upsert(rv, s)
+	})
+}
+
+func (m matchUpsert) upsert(rv reflect.Value, s MatcherSegment) bool {
+	if !rv.CanSet() {
+		return m.MatchExact.Match(rv, s)
+	}
+
+	before := *m.count
+	origRv := reflect.New(rv.Type()).Elem()
+	origRv.Set(rv)
+
+	created := m.allocate(rv)
+
+	var keepSearching bool
+	switch containerRv := Unbox(rv); containerRv.Kind() {
+	case reflect.Map:
+		keepSearching = m.upsertMap(containerRv, s)
+	case reflect.Slice:
+		keepSearching = m.upsertSlice(rv, containerRv, s)
+	default:
+		keepSearching = m.MatchExact.Match(rv, s)
+	}
+
+	// Revert the allocations if nothing was assigned.
+	if created && *m.count == before {
+		rv.Set(origRv)
+	}
+	return keepSearching
+}
+
+// Allocate nil pointers, maps, and interfaces so that they can be traversed.
+func (m matchUpsert) allocate(rv reflect.Value) bool {
+	created := false
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+			created = true
+		}
+	case reflect.Interface:
+		if rv.IsNil() {
+			var newRv reflect.Value
+			switch m.Value.(type) {
+			case string:
+				newRv = reflect.ValueOf(map[string]any{})
+			case int:
+				newRv = reflect.ValueOf([]any{})
+			}
+			if newRv.IsValid() && newRv.Type().AssignableTo(rv.Type()) {
+				rv.Set(newRv)
+				created = true
+			}
+		}
+	}
+
+	if containerRv := Unbox(rv); containerRv.Kind() == reflect.Map && containerRv.IsNil() {
+		if assignContainer(rv, containerRv, reflect.MakeMap(containerRv.Type())) {
+			created = true
+		}
+	}
+	return created
+}
+
+func (m matchUpsert) upsertMap(rv reflect.Value, s MatcherSegment) bool {
+	if s.Traveller().IgnoreMap() {
+		return true
+	}
+	keyRv, ok := convertKey(m.Value, rv.Type().Key(), s.Traveller().ParseKeys())
+	if !ok {
+		return true
+	}
+	if valueRv := rv.MapIndex(keyRv); valueRv.IsValid() {
+		return s.Next(valueRv, rv, keyRv)
+	}
+
+	before := *m.count
+	keepSearching := s.Next(reflect.Zero(rv.Type().Elem()), rv, keyRv)
+
+	// The entry is written back by the traversal handler regardless of assignment.
+	if *m.count == before {
+		rv.SetMapIndex(keyRv, reflect.Value{})
+	}
+	return keepSearching
+}
+
+func (m matchUpsert) upsertSlice(rv, sliceRv reflect.Value, s MatcherSegment) bool {
+	if s.Traveller().IgnoreArray() {
+		return true
+	}
+	i, ok := indexKey(m.Value, s.Traveller().ParseKeys())
+	if !ok {
+		return true
+	}
+	if i < sliceRv.Len() {
+		return s.Next(sliceRv.Index(i), sliceRv, i)
+	}
+	if i-sliceRv.Len() >= maxSliceGrowth {
+		return true
+	}
+
+	before := *m.count
+	origRv := reflect.New(sliceRv.Type()).Elem()
+	origRv.Set(sliceRv)
+	grownRv := reflect.MakeSlice(sliceRv.Type(), i+1, i+1)
+	reflect.Copy(grownRv, sliceRv)
+	if !assignContainer(rv, sliceRv, grownRv) {
+		return true
+	}
+
+	keepSearching := s.Next(grownRv.Index(i), grownRv, i)
+
+	if *m.count == before {
+		assignContainer(rv, Unbox(rv), origRv)
+	}
+	return keepSearching
+}
@@ -0,0 +1,63 @@
+package traveller_test
+
+import "github.com/ezraisw/traveller"
+
+func (s GeneralTestSuite) TestCallUpsertPanic() {
+	s.Panics(func() {
+		traveller.Upsert(chain{}, traveller.P("Name"), "value")
+	})
+}
+
+func (s GeneralTestSuite) TestCallUpsertExisting() {
+	actual := makeBulb()
+	count := traveller.Upsert(&actual, traveller.P("Cup.Blasphemy"), "edited")
+	s.Equal(1, count)
+
+	expected := makeBulb()
+	expected.Cup["Blasphemy"] = "edited"
+	s.Equal(expected, actual)
+}
+
+func (s GeneralTestSuite) TestCallUpsertCreate() {
+	actual := chain{}
+	parseKeys := traveller.WithParseKeys(true)
+	s.Equal(1, traveller.Upsert(&actual, traveller.P("Next.Cache.env"), "production"))
+	s.Equal(1, traveller.Upsert(&actual, traveller.P("Children.2.Name"), "third", parseKeys))
+	s.Equal(1, traveller.Upsert(&actual, traveller.P("Cache.event.name"), "created"))
+
+	s.Equal(chain{
+		Next:     &chain{Cache: map[string]any{"env": "production"}},
+		Children: []*chain{nil, nil, {Name: "third"}},
+		Cache:    map[string]any{"event": map[string]any{"name": "created"}},
+	}, actual)
+
+	counts := map[int]int{}
+	s.Equal(1, traveller.Upsert(&counts, traveller.P("7"), 49, parseKeys))
+	s.Equal(map[int]int{7: 49}, counts)
+}
+
+func (s GeneralTestSuite) TestCallUpsertRevert() {
+	actual := chain{}
+	s.Equal(0, traveller.Upsert(&actual, traveller.P("Next.Next.Name"), 1))
+	s.Equal(0, traveller.Upsert(&actual, traveller.P("Children.2.Name"), 1, traveller.WithParseKeys(true)))
+	s.Equal(0, traveller.Upsert(&actual, traveller.P("Children.999999999.Name"), "far", traveller.WithParseKeys(true)))
+	s.Equal(chain{}, actual)
+
+	counts := map[int]int{}
+	s.Equal(0, traveller.Upsert(&counts, traveller.P("invalid"), 1, traveller.WithParseKeys(true)))
+	s.Equal(map[int]int{}, counts)
+
+	labels := map[string]map[string]string{}
+	s.Equal(0, traveller.Upsert(&labels, traveller.P("env.team"), 1))
+	s.Equal(map[string]map[string]string{}, labels)
+}
+
+func (s GeneralTestSuite) TestCallUpsertBy() {
+	actual := map[string]int{"hits": 1}
+	increment := func(oldVal int) (any, bool, bool) {
+		return oldVal + 1, true, true
+	}
+	s.Equal(1, traveller.UpsertBy(&actual, traveller.P("hits"), increment))
+	s.Equal(1, traveller.UpsertBy(&actual, traveller.P("misses"), increment))
+	s.Equal(map[string]int{"hits": 2, "misses": 1}, actual)
+}